@@ -0,0 +1,182 @@
+package cache
+
+import (
+	"fmt"
+	"time"
+)
+
+// Cache is a bounded key/value store that evicts entries according to its
+// policy.
+//
+// Implementations returned by this package are not safe for concurrent use;
+// wrap them with NewSafe when they are shared between goroutines.
+type Cache[K comparable, V any] interface {
+	// Get returns the value stored for key and whether it was found. A hit
+	// counts as a use of the entry for the eviction policy.
+	Get(key K) (V, bool)
+	// Set stores val for key, evicting other entries if the capacity is
+	// exceeded. A value whose cost alone exceeds the capacity is rejected
+	// instead: it is handed to the eviction callback with Capacity, the
+	// previous value of key is evicted, and the other entries are kept.
+	Set(key K, val V)
+	// GetOrCompute returns the value stored for key, or computes, stores and
+	// returns it on a miss. A compute error is returned as is and nothing is
+	// stored.
+	GetOrCompute(key K, compute func() (V, error)) (V, error)
+	// Delete removes key and reports whether it was present.
+	Delete(key K) bool
+	// Len returns the number of entries, including expired entries that
+	// were not swept yet.
+	Len() int
+	// Purge removes every entry.
+	Purge()
+	// Stats returns the hit, miss and eviction counters.
+	Stats() Stats
+	// Snapshot returns the live entries as a plain map, without counting as
+	// a use of any entry.
+	Snapshot() map[K]V
+}
+
+// Stats holds the counters of a cache.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+// HitRatio returns the fraction of lookups that were hits, or 0 when there
+// was no lookup yet.
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+
+	return float64(s.Hits) / float64(total)
+}
+
+// String implements fmt.Stringer.
+func (s Stats) String() string {
+	return fmt.Sprintf("hits=%d misses=%d evictions=%d", s.Hits, s.Misses, s.Evictions)
+}
+
+// EvictReason tells an eviction callback why an entry left the cache.
+type EvictReason int
+
+const (
+	// Capacity means the entry was evicted to make room for others.
+	Capacity EvictReason = iota
+	// Expired means the entry outlived its time to live.
+	Expired
+	// Removed means the entry was removed by Delete or Purge. Removals
+	// are not counted as evictions in Stats.
+	Removed
+)
+
+// String implements fmt.Stringer.
+func (r EvictReason) String() string {
+	switch r {
+	case Capacity:
+		return "capacity"
+	case Expired:
+		return "expired"
+	case Removed:
+		return "removed"
+	}
+
+	return fmt.Sprintf("EvictReason(%d)", int(r))
+}
+
+type config[K comparable, V any] struct {
+	capacity int64
+	ttl      time.Duration
+	cost     func(K, V) int64
+	onEvict  func(K, V, EvictReason)
+	now      func() time.Time
+}
+
+// Option configures a cache.
+type Option[K comparable, V any] func(*config[K, V])
+
+// WithTTL makes every entry expire ttl after it was last set.
+func WithTTL[K comparable, V any](ttl time.Duration) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.ttl = ttl
+	}
+}
+
+// WithCost measures the capacity in the cost returned by fn instead of the
+// number of entries. fn is called once per Set.
+func WithCost[K comparable, V any](fn func(K, V) int64) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.cost = fn
+	}
+}
+
+// WithOnEvict registers a callback invoked whenever an entry leaves the
+// cache. It runs synchronously, so it must not call back into the cache.
+func WithOnEvict[K comparable, V any](fn func(K, V, EvictReason)) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.onEvict = fn
+	}
+}
+
+// WithClock replaces time.Now, which is mostly useful in tests of TTL
+// expiry.
+func WithClock[K comparable, V any](now func() time.Time) Option[K, V] {
+	return func(c *config[K, V]) {
+		c.now = now
+	}
+}
+
+func newConfig[K comparable, V any](capacity int64, opts []Option[K, V]) config[K, V] {
+	c := config[K, V]{
+		capacity: capacity,
+		cost:     func(K, V) int64 { return 1 },
+		now:      time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return c
+}
+
+// oversized reports whether an entry of the given cost can never fit.
+func (c *config[K, V]) oversized(cost int64) bool {
+	return c.capacity > 0 && cost > c.capacity
+}
+
+func (c *config[K, V]) expiry() time.Time {
+	if c.ttl <= 0 {
+		return time.Time{}
+	}
+
+	return c.now().Add(c.ttl)
+}
+
+func (c *config[K, V]) expired(e *entry[K, V]) bool {
+	return !e.expires.IsZero() && !c.now().Before(e.expires)
+}
+
+func (c *config[K, V]) evicted(e *entry[K, V], reason EvictReason) {
+	if c.onEvict != nil {
+		c.onEvict(e.key, e.val, reason)
+	}
+}
+
+// getOrCompute implements Cache.GetOrCompute on top of Get and Set.
+func getOrCompute[K comparable, V any](c Cache[K, V], key K, compute func() (V, error)) (V, error) {
+	if v, ok := c.Get(key); ok {
+		return v, nil
+	}
+
+	v, err := compute()
+	if err != nil {
+		return v, err
+	}
+
+	c.Set(key, v)
+	return v, nil
+}
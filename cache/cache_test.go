@@ -0,0 +1,252 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+type eviction struct {
+	Key    string
+	Reason EvictReason
+}
+
+func TestLRU(t *testing.T) {
+	var evicted []eviction
+	c := NewLRU[string, int](2, WithOnEvict(func(k string, _ int, r EvictReason) {
+		evicted = append(evicted, eviction{k, r})
+	}))
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a") // b is now the least recently used entry
+	c.Set("c", 3)
+
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("Expected b to be evicted")
+	}
+
+	if got := c.SnapshotOrdered().Keys(); !reflect.DeepEqual(got, []string{"c", "a"}) {
+		t.Errorf("SnapshotOrdered() = %v, want %v", got, []string{"c", "a"})
+	}
+
+	c.Delete("a")
+
+	expected := []eviction{{"b", Capacity}, {"a", Removed}}
+	if !reflect.DeepEqual(evicted, expected) {
+		t.Errorf("evictions = %v, want %v", evicted, expected)
+	}
+
+	if got := c.Stats(); got != (Stats{Hits: 1, Misses: 1, Evictions: 1}) {
+		t.Errorf("Stats() = %v", got)
+	}
+
+	if got := c.Stats().HitRatio(); got != 0.5 {
+		t.Errorf("HitRatio() = %v, want 0.5", got)
+	}
+}
+
+func TestLFU(t *testing.T) {
+	c := NewLFU[string, int](2)
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	c.Set("c", 3) // b has the lowest frequency
+
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("Expected b to be evicted")
+	}
+
+	if c.Frequency("a") != 3 || c.Frequency("c") != 1 {
+		t.Errorf("Frequency() = %d, %d, want 3, 1", c.Frequency("a"), c.Frequency("c"))
+	}
+
+	// Ties are broken by recency: c is older than d.
+	c.Set("d", 4)
+	if _, ok := c.Get("c"); ok {
+		t.Errorf("Expected c to be evicted")
+	}
+
+	if got := c.Snapshot(); !reflect.DeepEqual(got, map[string]int{"a": 1, "d": 4}) {
+		t.Errorf("Snapshot() = %v", got)
+	}
+
+	c.Purge()
+	if c.Len() != 0 {
+		t.Errorf("Len() = %d after Purge, want 0", c.Len())
+	}
+}
+
+func TestCostCapacity(t *testing.T) {
+	byLen := WithCost(func(_ string, v string) int64 { return int64(len(v)) })
+
+	for name, c := range map[string]Cache[string, string]{
+		"LRU": NewLRU(10, byLen),
+		"LFU": NewLFU(10, byLen),
+	} {
+		t.Run(name, func(t *testing.T) {
+			c.Set("a", "aaaa")
+			c.Set("b", "bbbb")
+			c.Set("c", "cccc") // 12 > 10, a must go
+
+			keys := make([]string, 0)
+			for k := range c.Snapshot() {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+
+			if !reflect.DeepEqual(keys, []string{"b", "c"}) {
+				t.Errorf("keys = %v, want %v", keys, []string{"b", "c"})
+			}
+
+			c.Set("huge", "xxxxxxxxxxxx")
+			if _, ok := c.Get("huge"); ok {
+				t.Errorf("Expected an entry larger than the capacity not to be kept")
+			}
+
+			if c.Len() != 2 {
+				t.Errorf("Expected an oversized entry to leave the others cached, but got %v", c.Snapshot())
+			}
+
+			c.Set("b", "xxxxxxxxxxxx")
+			if _, ok := c.Get("b"); ok || c.Len() != 1 {
+				t.Errorf("Expected an oversized update to evict only its key, but got %v", c.Snapshot())
+			}
+		})
+	}
+}
+
+func TestOversizedCallback(t *testing.T) {
+	var evicted []eviction
+	onEvict := WithOnEvict(func(k string, _ string, r EvictReason) { evicted = append(evicted, eviction{k, r}) })
+	byLen := WithCost(func(_ string, v string) int64 { return int64(len(v)) })
+
+	for name, c := range map[string]Cache[string, string]{
+		"LRU": NewLRU(10, byLen, onEvict),
+		"LFU": NewLFU(10, byLen, onEvict),
+	} {
+		t.Run(name, func(t *testing.T) {
+			evicted = nil
+
+			c.Set("a", "aaa")
+			c.Set("big", "xxxxxxxxxxx")
+
+			if expected := []eviction{{"big", Capacity}}; !reflect.DeepEqual(evicted, expected) {
+				t.Errorf("Expected %v, but got %v", expected, evicted)
+			}
+
+			if c.Len() != 1 || c.Stats().Evictions != 1 {
+				t.Errorf("Expected a to stay cached and one eviction, but got %v and %v", c.Snapshot(), c.Stats())
+			}
+		})
+	}
+}
+
+func TestTTL(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	var expired []string
+	c := NewTTL[string, int](0, time.Minute, WithClock[string, int](clock), WithOnEvict(func(k string, _ int, r EvictReason) {
+		if r == Expired {
+			expired = append(expired, k)
+		}
+	}))
+
+	c.Set("a", 1)
+	now = now.Add(30 * time.Second)
+	c.Set("b", 2)
+
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Errorf("Get(a) = %v, %v, want 1, true", v, ok)
+	}
+
+	now = now.Add(31 * time.Second)
+	if _, ok := c.Get("a"); ok {
+		t.Errorf("Expected a to be expired")
+	}
+
+	if got := c.Snapshot(); !reflect.DeepEqual(got, map[string]int{"b": 2}) {
+		t.Errorf("Snapshot() = %v", got)
+	}
+
+	now = now.Add(time.Minute)
+	if n := c.DeleteExpired(); n != 1 || c.Len() != 0 {
+		t.Errorf("DeleteExpired() = %d, Len() = %d, want 1, 0", n, c.Len())
+	}
+
+	if !reflect.DeepEqual(expired, []string{"a", "b"}) {
+		t.Errorf("expired = %v, want %v", expired, []string{"a", "b"})
+	}
+
+	lfu := NewLFU[string, int](0, WithTTL[string, int](time.Second), WithClock[string, int](clock))
+	lfu.Set("x", 1)
+	now = now.Add(time.Second)
+	if _, ok := lfu.Get("x"); ok {
+		t.Errorf("Expected x to be expired in the LFU cache")
+	}
+}
+
+func TestGetOrCompute(t *testing.T) {
+	c := NewLRU[int, string](10)
+	calls := 0
+	compute := func() (string, error) {
+		calls++
+		return "computed", nil
+	}
+
+	for i := 0; i < 3; i++ {
+		if v, err := c.GetOrCompute(1, compute); v != "computed" || err != nil {
+			t.Errorf("GetOrCompute() = %v, %v", v, err)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("Expected compute to run once, but got %d", calls)
+	}
+
+	errBoom := errors.New("boom")
+	if _, err := c.GetOrCompute(2, func() (string, error) { return "", errBoom }); !errors.Is(err, errBoom) {
+		t.Errorf("GetOrCompute() error = %v, want boom", err)
+	}
+
+	if _, ok := c.Peek(2); ok {
+		t.Errorf("Expected a failed computation not to be stored")
+	}
+}
+
+func TestSafe(t *testing.T) {
+	c := NewSafe[string, int](NewLRU[string, int](50))
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+
+			for i := 0; i < 200; i++ {
+				key := fmt.Sprintf("k%d", (g*31+i)%80)
+				c.Set(key, i)
+				c.Get(key)
+				_, _ = c.GetOrCompute(key+"x", func() (int, error) { return i, nil })
+				_ = c.Snapshot()
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if c.Len() > 50 {
+		t.Errorf("Len() = %d, want at most 50", c.Len())
+	}
+
+	if s := c.Stats(); s.Hits+s.Misses == 0 {
+		t.Errorf("Expected lookups to be counted, but got %v", s)
+	}
+}
@@ -0,0 +1,221 @@
+package cache
+
+// LFU is a cache that evicts the least frequently used entries first. Ties
+// are broken by evicting the least recently used entry of the lowest
+// frequency.
+//
+// Every operation runs in O(1), except evictions that empty the lowest
+// frequency bucket, which scan the remaining buckets.
+type LFU[K comparable, V any] struct {
+	cfg     config[K, V]
+	items   map[K]*entry[K, V]
+	buckets map[int]*list[K, V]
+	minFreq int
+	cost    int64
+	stats   Stats
+}
+
+var _ Cache[string, int] = (*LFU[string, int])(nil)
+
+// NewLFU returns an LFU cache holding at most capacity entries, or at most
+// capacity total cost when WithCost is given. A non-positive capacity means
+// the cache is unbounded.
+func NewLFU[K comparable, V any](capacity int64, opts ...Option[K, V]) *LFU[K, V] {
+	return &LFU[K, V]{
+		cfg:     newConfig(capacity, opts),
+		items:   make(map[K]*entry[K, V]),
+		buckets: make(map[int]*list[K, V]),
+	}
+}
+
+// Get implements Cache.
+func (c *LFU[K, V]) Get(key K) (V, bool) {
+	e, ok := c.items[key]
+	if ok && c.cfg.expired(e) {
+		c.removeEntry(e, Expired)
+		ok = false
+	}
+
+	if !ok {
+		c.stats.Misses++
+
+		var zero V
+		return zero, false
+	}
+
+	c.stats.Hits++
+	c.touch(e)
+
+	return e.val, true
+}
+
+// Frequency returns how many times key was used, counting the Set that
+// inserted it, or 0 if it is not cached.
+func (c *LFU[K, V]) Frequency(key K) int {
+	if e, ok := c.items[key]; ok {
+		return e.freq
+	}
+
+	return 0
+}
+
+// Set implements Cache. Updating an existing key counts as a use. A value
+// whose cost exceeds the capacity is rejected without evicting the other
+// entries.
+func (c *LFU[K, V]) Set(key K, val V) {
+	cost := c.cfg.cost(key, val)
+
+	if c.cfg.oversized(cost) {
+		c.reject(key, val, cost)
+		return
+	}
+
+	if e, ok := c.items[key]; ok {
+		c.cost += cost - e.cost
+		e.val, e.cost, e.expires = val, cost, c.cfg.expiry()
+		c.touch(e)
+		c.evict()
+		return
+	}
+
+	// Make room before inserting, so the new entry is not its own victim.
+	c.cost += cost
+	c.evict()
+
+	e := &entry[K, V]{key: key, val: val, cost: cost, freq: 1, expires: c.cfg.expiry()}
+	c.items[key] = e
+	c.bucket(1).pushFront(e)
+	c.minFreq = 1
+}
+
+// GetOrCompute implements Cache.
+func (c *LFU[K, V]) GetOrCompute(key K, compute func() (V, error)) (V, error) {
+	return getOrCompute[K, V](c, key, compute)
+}
+
+// Delete implements Cache.
+func (c *LFU[K, V]) Delete(key K) bool {
+	e, ok := c.items[key]
+	if !ok {
+		return false
+	}
+
+	c.removeEntry(e, Removed)
+	return true
+}
+
+// Len implements Cache.
+func (c *LFU[K, V]) Len() int {
+	return len(c.items)
+}
+
+// Purge implements Cache.
+func (c *LFU[K, V]) Purge() {
+	for _, e := range c.items {
+		c.removeEntry(e, Removed)
+	}
+}
+
+// Stats implements Cache.
+func (c *LFU[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// Snapshot implements Cache.
+func (c *LFU[K, V]) Snapshot() map[K]V {
+	result := make(map[K]V, len(c.items))
+	for k, e := range c.items {
+		if !c.cfg.expired(e) {
+			result[k] = e.val
+		}
+	}
+
+	return result
+}
+
+func (c *LFU[K, V]) bucket(freq int) *list[K, V] {
+	l, ok := c.buckets[freq]
+	if !ok {
+		l = newList[K, V]()
+		c.buckets[freq] = l
+	}
+
+	return l
+}
+
+func (c *LFU[K, V]) unlink(e *entry[K, V]) {
+	l := c.buckets[e.freq]
+	l.remove(e)
+
+	if l.len == 0 {
+		delete(c.buckets, e.freq)
+	}
+}
+
+func (c *LFU[K, V]) touch(e *entry[K, V]) {
+	c.unlink(e)
+	if e.freq == c.minFreq && c.buckets[e.freq] == nil {
+		c.minFreq++
+	}
+
+	e.freq++
+	c.bucket(e.freq).pushFront(e)
+}
+
+func (c *LFU[K, V]) removeEntry(e *entry[K, V], reason EvictReason) {
+	c.unlink(e)
+	delete(c.items, e.key)
+	c.cost -= e.cost
+
+	if reason != Removed {
+		c.stats.Evictions++
+	}
+
+	c.cfg.evicted(e, reason)
+}
+
+// reject drops a value too large for the cache, without flushing the other
+// entries to make room for it.
+func (c *LFU[K, V]) reject(key K, val V, cost int64) {
+	if e, ok := c.items[key]; ok {
+		c.removeEntry(e, Capacity)
+	}
+
+	c.stats.Evictions++
+	c.cfg.evicted(&entry[K, V]{key: key, val: val, cost: cost}, Capacity)
+}
+
+// lowest returns the least recently used entry of the lowest frequency.
+func (c *LFU[K, V]) lowest() *entry[K, V] {
+	if l, ok := c.buckets[c.minFreq]; ok {
+		return l.back()
+	}
+
+	if len(c.buckets) == 0 {
+		return nil
+	}
+
+	c.minFreq = 0
+	for freq := range c.buckets {
+		if c.minFreq == 0 || freq < c.minFreq {
+			c.minFreq = freq
+		}
+	}
+
+	return c.buckets[c.minFreq].back()
+}
+
+func (c *LFU[K, V]) evict() {
+	if c.cfg.capacity <= 0 {
+		return
+	}
+
+	for c.cost > c.cfg.capacity {
+		e := c.lowest()
+		if e == nil {
+			return
+		}
+
+		c.removeEntry(e, Capacity)
+	}
+}
@@ -0,0 +1,66 @@
+package cache
+
+import "time"
+
+type entry[K comparable, V any] struct {
+	key     K
+	val     V
+	cost    int64
+	freq    int
+	expires time.Time
+
+	prev, next *entry[K, V]
+}
+
+// list is a typed doubly linked list with a sentinel root, ordered from the
+// most recently used entry (front) to the least recently used one (back).
+type list[K comparable, V any] struct {
+	root entry[K, V]
+	len  int
+}
+
+func newList[K comparable, V any]() *list[K, V] {
+	l := &list[K, V]{}
+	l.root.next = &l.root
+	l.root.prev = &l.root
+
+	return l
+}
+
+func (l *list[K, V]) pushFront(e *entry[K, V]) {
+	e.prev = &l.root
+	e.next = l.root.next
+	l.root.next.prev = e
+	l.root.next = e
+	l.len++
+}
+
+func (l *list[K, V]) remove(e *entry[K, V]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.prev, e.next = nil, nil
+	l.len--
+}
+
+func (l *list[K, V]) moveToFront(e *entry[K, V]) {
+	l.remove(e)
+	l.pushFront(e)
+}
+
+func (l *list[K, V]) back() *entry[K, V] {
+	if l.len == 0 {
+		return nil
+	}
+
+	return l.root.prev
+}
+
+// each visits the entries from front to back. fn may remove the visited
+// entry.
+func (l *list[K, V]) each(fn func(e *entry[K, V])) {
+	for e := l.root.next; e != &l.root; {
+		next := e.next
+		fn(e)
+		e = next
+	}
+}
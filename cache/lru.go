@@ -0,0 +1,195 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/cirius-go/generic/record"
+)
+
+// LRU is a cache that evicts the least recently used entries first.
+type LRU[K comparable, V any] struct {
+	cfg   config[K, V]
+	items map[K]*entry[K, V]
+	order *list[K, V]
+	cost  int64
+	stats Stats
+}
+
+var _ Cache[string, int] = (*LRU[string, int])(nil)
+
+// NewLRU returns an LRU cache holding at most capacity entries, or at most
+// capacity total cost when WithCost is given. A non-positive capacity means
+// the cache is unbounded, which is mostly useful together with WithTTL.
+func NewLRU[K comparable, V any](capacity int64, opts ...Option[K, V]) *LRU[K, V] {
+	return &LRU[K, V]{
+		cfg:   newConfig(capacity, opts),
+		items: make(map[K]*entry[K, V]),
+		order: newList[K, V](),
+	}
+}
+
+// NewTTL returns an LRU cache whose entries also expire ttl after they were
+// last set.
+func NewTTL[K comparable, V any](capacity int64, ttl time.Duration, opts ...Option[K, V]) *LRU[K, V] {
+	return NewLRU(capacity, append([]Option[K, V]{WithTTL[K, V](ttl)}, opts...)...)
+}
+
+// Get implements Cache.
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	e, ok := c.items[key]
+	if ok && c.cfg.expired(e) {
+		c.removeEntry(e, Expired)
+		ok = false
+	}
+
+	if !ok {
+		c.stats.Misses++
+
+		var zero V
+		return zero, false
+	}
+
+	c.stats.Hits++
+	c.order.moveToFront(e)
+
+	return e.val, true
+}
+
+// Peek returns the value stored for key without counting as a use of the
+// entry or touching the statistics.
+func (c *LRU[K, V]) Peek(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok || c.cfg.expired(e) {
+		var zero V
+		return zero, false
+	}
+
+	return e.val, true
+}
+
+// Set implements Cache. A value whose cost exceeds the capacity is rejected
+// without evicting the other entries.
+func (c *LRU[K, V]) Set(key K, val V) {
+	cost := c.cfg.cost(key, val)
+
+	if c.cfg.oversized(cost) {
+		c.reject(key, val, cost)
+		return
+	}
+
+	if e, ok := c.items[key]; ok {
+		c.cost += cost - e.cost
+		e.val, e.cost, e.expires = val, cost, c.cfg.expiry()
+		c.order.moveToFront(e)
+	} else {
+		e := &entry[K, V]{key: key, val: val, cost: cost, expires: c.cfg.expiry()}
+		c.items[key] = e
+		c.order.pushFront(e)
+		c.cost += cost
+	}
+
+	c.evict()
+}
+
+// GetOrCompute implements Cache.
+func (c *LRU[K, V]) GetOrCompute(key K, compute func() (V, error)) (V, error) {
+	return getOrCompute[K, V](c, key, compute)
+}
+
+// Delete implements Cache.
+func (c *LRU[K, V]) Delete(key K) bool {
+	e, ok := c.items[key]
+	if !ok {
+		return false
+	}
+
+	c.removeEntry(e, Removed)
+	return true
+}
+
+// Len implements Cache.
+func (c *LRU[K, V]) Len() int {
+	return len(c.items)
+}
+
+// Purge implements Cache.
+func (c *LRU[K, V]) Purge() {
+	c.order.each(func(e *entry[K, V]) {
+		c.removeEntry(e, Removed)
+	})
+}
+
+// DeleteExpired removes every expired entry and returns how many were
+// removed. Expired entries are otherwise only removed when looked up.
+func (c *LRU[K, V]) DeleteExpired() int {
+	removed := 0
+	c.order.each(func(e *entry[K, V]) {
+		if c.cfg.expired(e) {
+			c.removeEntry(e, Expired)
+			removed++
+		}
+	})
+
+	return removed
+}
+
+// Stats implements Cache.
+func (c *LRU[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// Snapshot implements Cache.
+func (c *LRU[K, V]) Snapshot() map[K]V {
+	return c.SnapshotOrdered().ToMap()
+}
+
+// SnapshotOrdered returns the live entries as a record.OrderedMap, from the
+// most recently used to the least recently used one.
+func (c *LRU[K, V]) SnapshotOrdered() *record.OrderedMap[K, V] {
+	result := record.NewOrderedMap[K, V]()
+	c.order.each(func(e *entry[K, V]) {
+		if !c.cfg.expired(e) {
+			result.Set(e.key, e.val)
+		}
+	})
+
+	return result
+}
+
+func (c *LRU[K, V]) removeEntry(e *entry[K, V], reason EvictReason) {
+	c.order.remove(e)
+	delete(c.items, e.key)
+	c.cost -= e.cost
+
+	if reason != Removed {
+		c.stats.Evictions++
+	}
+
+	c.cfg.evicted(e, reason)
+}
+
+// reject drops a value too large for the cache, without flushing the other
+// entries to make room for it.
+func (c *LRU[K, V]) reject(key K, val V, cost int64) {
+	if e, ok := c.items[key]; ok {
+		c.removeEntry(e, Capacity)
+	}
+
+	c.stats.Evictions++
+	c.cfg.evicted(&entry[K, V]{key: key, val: val, cost: cost}, Capacity)
+}
+
+func (c *LRU[K, V]) evict() {
+	if c.cfg.capacity <= 0 {
+		return
+	}
+
+	for c.cost > c.cfg.capacity {
+		e := c.order.back()
+		if e == nil {
+			return
+		}
+
+		c.removeEntry(e, Capacity)
+	}
+}
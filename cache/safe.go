@@ -0,0 +1,85 @@
+package cache
+
+import "sync"
+
+// Safe wraps a Cache with a mutex so it can be shared between goroutines.
+//
+// A plain mutex is used rather than a RWMutex because every lookup updates
+// the eviction bookkeeping of the underlying cache.
+type Safe[K comparable, V any] struct {
+	mu    sync.Mutex
+	cache Cache[K, V]
+}
+
+var _ Cache[string, int] = (*Safe[string, int])(nil)
+
+// NewSafe returns a concurrency-safe view of c. c must not be used directly
+// afterwards.
+func NewSafe[K comparable, V any](c Cache[K, V]) *Safe[K, V] {
+	return &Safe[K, V]{cache: c}
+}
+
+// Get implements Cache.
+func (s *Safe[K, V]) Get(key K) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Get(key)
+}
+
+// Set implements Cache.
+func (s *Safe[K, V]) Set(key K, val V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cache.Set(key, val)
+}
+
+// GetOrCompute implements Cache.
+//
+// The lock is not held while compute runs, so a slow computation does not
+// block other keys. Concurrent misses on the same key may therefore compute
+// it more than once; the last result wins.
+func (s *Safe[K, V]) GetOrCompute(key K, compute func() (V, error)) (V, error) {
+	return getOrCompute[K, V](s, key, compute)
+}
+
+// Delete implements Cache.
+func (s *Safe[K, V]) Delete(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Delete(key)
+}
+
+// Len implements Cache.
+func (s *Safe[K, V]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Len()
+}
+
+// Purge implements Cache.
+func (s *Safe[K, V]) Purge() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cache.Purge()
+}
+
+// Stats implements Cache.
+func (s *Safe[K, V]) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Stats()
+}
+
+// Snapshot implements Cache.
+func (s *Safe[K, V]) Snapshot() map[K]V {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Snapshot()
+}
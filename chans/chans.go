@@ -0,0 +1,325 @@
+package chans
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Every function of this package that returns a channel starts a goroutine
+// feeding it. The channel is closed, and the goroutine exits, once the
+// input is exhausted or ctx is done, so canceling ctx is enough to tear a
+// pipeline down even if nobody drains its output.
+
+// send delivers v on out unless ctx is done first, and reports whether it
+// did.
+func send[T any](ctx context.Context, out chan<- T, v T) bool {
+	select {
+	case out <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// FromSlice returns a channel that yields items in order.
+func FromSlice[T any](ctx context.Context, items ...T) <-chan T {
+	out := make(chan T)
+
+	go func() {
+		defer close(out)
+
+		for _, v := range items {
+			if !send(ctx, out, v) {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// ToSlice reads in until it is closed and returns the values received. If
+// ctx is done first, it returns the values received so far and the cause of
+// the cancellation.
+func ToSlice[T any](ctx context.Context, in <-chan T) ([]T, error) {
+	result := make([]T, 0)
+
+	for {
+		select {
+		case v, ok := <-in:
+			if !ok {
+				return result, nil
+			}
+
+			result = append(result, v)
+		case <-ctx.Done():
+			return result, context.Cause(ctx)
+		}
+	}
+}
+
+// OrDone returns a channel that yields the values of in until in is closed
+// or ctx is done. It lets a consumer range over a channel it does not own
+// without blocking forever after a cancellation.
+func OrDone[T any](ctx context.Context, in <-chan T) <-chan T {
+	out := make(chan T)
+
+	go func() {
+		defer close(out)
+
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok || !send(ctx, out, v) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Map returns a channel that yields callback applied to each value of in.
+func Map[T, R any](ctx context.Context, callback func(T) R, in <-chan T) <-chan R {
+	out := make(chan R)
+
+	go func() {
+		defer close(out)
+
+		for v := range OrDone(ctx, in) {
+			if !send(ctx, out, callback(v)) {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Filter returns a channel that yields the values of in for which predicate
+// returns true.
+func Filter[T any](ctx context.Context, predicate func(T) bool, in <-chan T) <-chan T {
+	out := make(chan T)
+
+	go func() {
+		defer close(out)
+
+		for v := range OrDone(ctx, in) {
+			if predicate(v) && !send(ctx, out, v) {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Merge fans in: it returns a channel that yields the values of every input
+// as they arrive, and is closed once all inputs are closed.
+func Merge[T any](ctx context.Context, ins ...<-chan T) <-chan T {
+	out := make(chan T)
+
+	var wg sync.WaitGroup
+	wg.Add(len(ins))
+
+	for _, in := range ins {
+		go func(in <-chan T) {
+			defer wg.Done()
+
+			for v := range OrDone(ctx, in) {
+				if !send(ctx, out, v) {
+					return
+				}
+			}
+		}(in)
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
+
+// Broadcast fans out: it returns n channels that each yield every value of
+// in. A value is handed to every output before the next one is read, so the
+// slowest consumer sets the pace of all of them.
+func Broadcast[T any](ctx context.Context, n int, in <-chan T) []<-chan T {
+	outs := make([]chan T, max(n, 0))
+	result := make([]<-chan T, len(outs))
+
+	for i := range outs {
+		outs[i] = make(chan T)
+		result[i] = outs[i]
+	}
+
+	go func() {
+		defer func() {
+			for _, out := range outs {
+				close(out)
+			}
+		}()
+
+		for v := range OrDone(ctx, in) {
+			for _, out := range outs {
+				if !send(ctx, out, v) {
+					return
+				}
+			}
+		}
+	}()
+
+	return result
+}
+
+// Tee returns two channels that both yield every value of in. It is
+// Broadcast with two outputs.
+func Tee[T any](ctx context.Context, in <-chan T) (<-chan T, <-chan T) {
+	outs := Broadcast(ctx, 2, in)
+
+	return outs[0], outs[1]
+}
+
+// Batch groups the values of in into slices of at most size values, like
+// slice.Divide does for a slice. A batch is emitted as soon as it is full,
+// or timeout after its first value arrived, whichever comes first; a
+// non-positive timeout only emits full batches. The last, possibly shorter,
+// batch is emitted when in is closed.
+//
+// It panics if size is less than 1.
+func Batch[T any](ctx context.Context, size int, timeout time.Duration, in <-chan T) <-chan []T {
+	if size < 1 {
+		panic("chans: batch size must be at least 1")
+	}
+
+	out := make(chan []T)
+
+	go func() {
+		defer close(out)
+
+		timer := time.NewTimer(timeout)
+		timer.Stop()
+		defer timer.Stop()
+
+		var (
+			batch   []T
+			expired <-chan time.Time
+		)
+
+		flush := func() bool {
+			expired = nil
+			timer.Stop()
+
+			if len(batch) == 0 {
+				return true
+			}
+
+			ok := send(ctx, out, batch)
+			batch = nil
+
+			return ok
+		}
+
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+
+				batch = append(batch, v)
+				if len(batch) == 1 && timeout > 0 {
+					timer.Reset(timeout)
+					expired = timer.C
+				}
+
+				if len(batch) >= size && !flush() {
+					return
+				}
+			case <-expired:
+				if !flush() {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Debounce returns a channel that yields a value of in only once no other
+// value arrived for d, so bursts collapse into their last value. A pending
+// value is still emitted when in is closed.
+func Debounce[T any](ctx context.Context, d time.Duration, in <-chan T) <-chan T {
+	out := make(chan T)
+
+	go func() {
+		defer close(out)
+
+		timer := time.NewTimer(d)
+		timer.Stop()
+		defer timer.Stop()
+
+		var (
+			pending T
+			quiet   <-chan time.Time
+		)
+
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					if quiet != nil {
+						send(ctx, out, pending)
+					}
+
+					return
+				}
+
+				pending = v
+				timer.Reset(d)
+				quiet = timer.C
+			case <-quiet:
+				quiet = nil
+				if !send(ctx, out, pending) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Throttle returns a channel that yields at most one value of in per period
+// d. The first value is emitted right away and values arriving before d has
+// elapsed since the last emitted one are dropped.
+func Throttle[T any](ctx context.Context, d time.Duration, in <-chan T) <-chan T {
+	out := make(chan T)
+
+	go func() {
+		defer close(out)
+
+		var last time.Time
+		for v := range OrDone(ctx, in) {
+			if now := time.Now(); last.IsZero() || now.Sub(last) >= d {
+				last = now
+				if !send(ctx, out, v) {
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
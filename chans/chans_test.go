@@ -0,0 +1,219 @@
+package chans
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"runtime"
+	"slices"
+	"testing"
+	"time"
+)
+
+// checkLeaks fails the test if goroutines started during it are still
+// running shortly after it returns.
+func checkLeaks(t *testing.T) {
+	t.Helper()
+
+	before := runtime.NumGoroutine()
+	t.Cleanup(func() {
+		deadline := time.Now().Add(time.Second)
+		for runtime.NumGoroutine() > before {
+			if time.Now().After(deadline) {
+				buf := make([]byte, 1<<16)
+				t.Errorf("leaked %d goroutines:\n%s", runtime.NumGoroutine()-before, buf[:runtime.Stack(buf, true)])
+				return
+			}
+
+			time.Sleep(5 * time.Millisecond)
+		}
+	})
+}
+
+func collect[T any](t *testing.T, in <-chan T) []T {
+	t.Helper()
+
+	got, err := ToSlice(context.Background(), in)
+	if err != nil {
+		t.Fatalf("ToSlice() error = %v", err)
+	}
+
+	return got
+}
+
+func TestPipeline(t *testing.T) {
+	checkLeaks(t)
+	ctx := context.Background()
+
+	evens := Filter(ctx, func(v int) bool { return v%2 == 0 }, FromSlice(ctx, 1, 2, 3, 4, 5, 6))
+	squares := Map(ctx, func(v int) int { return v * v }, evens)
+
+	if got := collect(t, squares); !reflect.DeepEqual(got, []int{4, 16, 36}) {
+		t.Errorf("Expected %v, but got %v", []int{4, 16, 36}, got)
+	}
+}
+
+func TestCancelWithoutDraining(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	in := FromSlice(ctx, 1, 2, 3, 4, 5)
+	outs := Broadcast(ctx, 3, Map(ctx, func(v int) int { return v }, in))
+	merged := Merge(ctx, outs[0], outs[1])
+	Batch(ctx, 2, time.Minute, merged)
+	Debounce(ctx, time.Minute, outs[2])
+
+	// Nothing is read: every stage is blocked on a send until cancel.
+	cancel()
+}
+
+func TestToSliceCancel(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancelCause(context.Background())
+	errStop := errors.New("stop")
+
+	in := make(chan int)
+	go func() {
+		in <- 1
+		cancel(errStop)
+	}()
+
+	got, err := ToSlice(ctx, in)
+	if !errors.Is(err, errStop) || !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("ToSlice() = %v, %v, want [1], stop", got, err)
+	}
+}
+
+func TestOrDone(t *testing.T) {
+	checkLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	never := make(chan int)
+	out := OrDone(ctx, never)
+	cancel()
+
+	if _, ok := <-out; ok {
+		t.Errorf("Expected OrDone to close its output on cancel")
+	}
+}
+
+func TestMerge(t *testing.T) {
+	checkLeaks(t)
+	ctx := context.Background()
+
+	got := collect(t, Merge(ctx, FromSlice(ctx, 1, 2), FromSlice(ctx, 3), FromSlice[int](ctx)))
+	slices.Sort(got)
+
+	if !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Expected %v, but got %v", []int{1, 2, 3}, got)
+	}
+
+	if got := collect(t, Merge[int](ctx)); len(got) != 0 {
+		t.Errorf("Expected no value, but got %v", got)
+	}
+}
+
+func TestTee(t *testing.T) {
+	checkLeaks(t)
+	ctx := context.Background()
+
+	a, b := Tee(ctx, FromSlice(ctx, "x", "y"))
+
+	var gotA, gotB []string
+	for a != nil || b != nil {
+		select {
+		case v, ok := <-a:
+			if !ok {
+				a = nil
+				continue
+			}
+			gotA = append(gotA, v)
+		case v, ok := <-b:
+			if !ok {
+				b = nil
+				continue
+			}
+			gotB = append(gotB, v)
+		}
+	}
+
+	if !reflect.DeepEqual(gotA, []string{"x", "y"}) || !reflect.DeepEqual(gotB, gotA) {
+		t.Errorf("Tee() = %v, %v", gotA, gotB)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	checkLeaks(t)
+	ctx := context.Background()
+
+	t.Run("size", func(t *testing.T) {
+		got := collect(t, Batch(ctx, 2, 0, FromSlice(ctx, 1, 2, 3, 4, 5)))
+		if !reflect.DeepEqual(got, [][]int{{1, 2}, {3, 4}, {5}}) {
+			t.Errorf("Batch() = %v", got)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		in := make(chan int)
+		out := Batch(ctx, 10, 100*time.Millisecond, in)
+
+		in <- 1
+		in <- 2
+		if got := <-out; !reflect.DeepEqual(got, []int{1, 2}) {
+			t.Errorf("Expected the partial batch after the timeout, but got %v", got)
+		}
+
+		in <- 3
+		close(in)
+		if got := collect(t, out); !reflect.DeepEqual(got, [][]int{{3}}) {
+			t.Errorf("Expected the last batch on close, but got %v", got)
+		}
+	})
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected Batch to panic on a size of 0")
+		}
+	}()
+	Batch(ctx, 0, 0, make(chan int))
+}
+
+func TestDebounce(t *testing.T) {
+	checkLeaks(t)
+	ctx := context.Background()
+
+	in := make(chan int)
+	out := Debounce(ctx, 100*time.Millisecond, in)
+
+	in <- 1
+	in <- 2
+	in <- 3
+	if got := <-out; got != 3 {
+		t.Errorf("Expected the burst to collapse into 3, but got %v", got)
+	}
+
+	in <- 4
+	close(in)
+	if got := collect(t, out); !reflect.DeepEqual(got, []int{4}) {
+		t.Errorf("Expected the pending value on close, but got %v", got)
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	checkLeaks(t)
+	ctx := context.Background()
+
+	in := make(chan int)
+	out := Throttle(ctx, time.Hour, in)
+
+	go func() {
+		for i := 1; i <= 5; i++ {
+			in <- i
+		}
+		close(in)
+	}()
+
+	if got := collect(t, out); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("Expected only the first value within the period, but got %v", got)
+	}
+}
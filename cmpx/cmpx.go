@@ -0,0 +1,160 @@
+package cmpx
+
+import (
+	"cmp"
+	"reflect"
+	"slices"
+	"sort"
+)
+
+// Comparator compares two values. It returns a negative number when a sorts
+// before b, zero when they are equivalent and a positive number when a sorts
+// after b, like cmp.Compare.
+//
+// Comparators can be passed directly to slice.SortFunc, slice.Sorted and
+// slice.TopK. Less adapts a
+// Comparator to the heap package and CompareFn to slice.UniqueElem.
+type Comparator[T any] func(a, b T) int
+
+// Natural returns the Comparator of the natural order of T.
+func Natural[T cmp.Ordered]() Comparator[T] {
+	return cmp.Compare[T]
+}
+
+// Ascending returns a Comparator that orders values by the key in ascending
+// order.
+func Ascending[T any, K cmp.Ordered](key func(T) K) Comparator[T] {
+	return func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	}
+}
+
+// Descending returns a Comparator that orders values by the key in
+// descending order.
+func Descending[T any, K cmp.Ordered](key func(T) K) Comparator[T] {
+	return func(a, b T) int {
+		return cmp.Compare(key(b), key(a))
+	}
+}
+
+// By returns a Comparator that orders values by the key, using c to compare
+// the keys.
+func By[T, K any](key func(T) K, c Comparator[K]) Comparator[T] {
+	return func(a, b T) int {
+		return c(key(a), key(b))
+	}
+}
+
+// Then returns a Comparator that uses next to break the ties of c.
+func (c Comparator[T]) Then(next Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		if r := c(a, b); r != 0 {
+			return r
+		}
+
+		return next(a, b)
+	}
+}
+
+// Reverse returns a Comparator that orders values in the opposite direction
+// of c.
+func (c Comparator[T]) Reverse() Comparator[T] {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+// ThenBy returns a Comparator that breaks the ties of c by the key in
+// ascending order.
+func ThenBy[T any, K cmp.Ordered](c Comparator[T], key func(T) K) Comparator[T] {
+	return c.Then(Ascending(key))
+}
+
+// ThenByDescending returns a Comparator that breaks the ties of c by the key
+// in descending order.
+func ThenByDescending[T any, K cmp.Ordered](c Comparator[T], key func(T) K) Comparator[T] {
+	return c.Then(Descending(key))
+}
+
+// NullsFirst returns a Comparator of pointers that orders nil before any
+// other pointer and compares the pointed-to values with c.
+func NullsFirst[T any](c Comparator[T]) Comparator[*T] {
+	return nulls(c, -1)
+}
+
+// NullsLast returns a Comparator of pointers that orders nil after any other
+// pointer and compares the pointed-to values with c.
+func NullsLast[T any](c Comparator[T]) Comparator[*T] {
+	return nulls(c, 1)
+}
+
+func nulls[T any](c Comparator[T], nilOrder int) Comparator[*T] {
+	return func(a, b *T) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return nilOrder
+		case b == nil:
+			return -nilOrder
+		}
+
+		return c(*a, *b)
+	}
+}
+
+// Less returns the less function of c, as expected by heap.New.
+func (c Comparator[T]) Less() func(a, b T) bool {
+	return func(a, b T) bool {
+		return c(a, b) < 0
+	}
+}
+
+// CompareFn returns a function that reports whether c finds two values
+// equivalent. It can be passed as a slice.CompareFn to slice.UniqueElem.
+func (c Comparator[T]) CompareFn() func(a, b T) bool {
+	return func(a, b T) bool {
+		return c(a, b) == 0
+	}
+}
+
+// Sort returns a sort.Interface that sorts items with c.
+func Sort[T any](c Comparator[T], items []T) sort.Interface {
+	return &sorter[T]{items: items, compare: c}
+}
+
+type sorter[T any] struct {
+	items   []T
+	compare Comparator[T]
+}
+
+func (s *sorter[T]) Len() int           { return len(s.items) }
+func (s *sorter[T]) Less(i, j int) bool { return s.compare(s.items[i], s.items[j]) < 0 }
+func (s *sorter[T]) Swap(i, j int)      { s.items[i], s.items[j] = s.items[j], s.items[i] }
+
+// Search looks for target in items, which must be sorted by c. It returns the
+// position where target is or would be inserted, and whether it was found.
+func Search[T any](c Comparator[T], items []T, target T) (int, bool) {
+	return slices.BinarySearchFunc(items, target, c)
+}
+
+// Equal reports whether a and b are deeply equal, like reflect.DeepEqual.
+//
+// When T has an Equal(T) bool method, such as time.Time, it is used instead,
+// since the internal representation of such types may differ between equal
+// values.
+func Equal[T any](a, b T) bool {
+	if eq, ok := any(a).(interface{ Equal(T) bool }); ok {
+		return eq.Equal(b)
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// EqualBy returns a function that reports whether the keys extracted by key
+// are equal, as reported by Equal. It can be passed as a slice.CompareFn.
+func EqualBy[T, K any](key func(T) K) func(a, b T) bool {
+	return func(a, b T) bool {
+		return Equal(key(a), key(b))
+	}
+}
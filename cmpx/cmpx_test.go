@@ -0,0 +1,139 @@
+package cmpx
+
+import (
+	"reflect"
+	"slices"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/cirius-go/generic/heap"
+)
+
+type person struct {
+	Name string
+	Age  int
+}
+
+func names(items []person) []string {
+	result := make([]string, 0, len(items))
+	for _, p := range items {
+		result = append(result, p.Name)
+	}
+
+	return result
+}
+
+func sorted[T any](c Comparator[T], items ...T) []T {
+	result := slices.Clone(items)
+	slices.SortStableFunc(result, c)
+
+	return result
+}
+
+var people = []person{
+	{"carol", 35},
+	{"alice", 30},
+	{"bob", 25},
+	{"dave", 30},
+}
+
+func TestComparator(t *testing.T) {
+	age := func(p person) int { return p.Age }
+	name := func(p person) string { return p.Name }
+	byAge := Ascending(age)
+
+	tests := []struct {
+		name string
+		c    Comparator[person]
+		want []string
+	}{
+		{"ascending", byAge, []string{"bob", "alice", "dave", "carol"}},
+		{"descending", Descending(age), []string{"carol", "alice", "dave", "bob"}},
+		{"reverse", byAge.Reverse(), []string{"carol", "alice", "dave", "bob"}},
+		{"then", byAge.Then(Ascending(name).Reverse()), []string{"bob", "dave", "alice", "carol"}},
+		{"then by", ThenBy(byAge, name), []string{"bob", "alice", "dave", "carol"}},
+		{"then by descending", ThenByDescending(byAge, name), []string{"bob", "dave", "alice", "carol"}},
+		{"by", By(name, Natural[string]().Reverse()), []string{"dave", "carol", "bob", "alice"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := names(sorted(tt.c, people...)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %v, but got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNulls(t *testing.T) {
+	one, two := 1, 2
+	items := []*int{&two, nil, &one}
+
+	first := sorted(NullsFirst(Natural[int]()), items...)
+	if !reflect.DeepEqual(first, []*int{nil, &one, &two}) {
+		t.Errorf("Expected nil first, but got %v", first)
+	}
+
+	last := sorted(NullsLast(Natural[int]()), items...)
+	if !reflect.DeepEqual(last, []*int{&one, &two, nil}) {
+		t.Errorf("Expected nil last, but got %v", last)
+	}
+
+	if got := NullsFirst(Natural[int]())(nil, nil); got != 0 {
+		t.Errorf("Expected two nil pointers to be equivalent, but got %d", got)
+	}
+}
+
+func TestAdapters(t *testing.T) {
+	byAge := Ascending(func(p person) int { return p.Age })
+
+	if equal := byAge.CompareFn(); !equal(people[1], people[3]) || equal(people[0], people[1]) {
+		t.Errorf("Expected CompareFn to report equivalent ages")
+	}
+
+	items := slices.Clone(people)
+	sort.Stable(Sort(byAge, items))
+
+	if want := sorted(byAge, people...); !reflect.DeepEqual(items, want) {
+		t.Errorf("Expected %v, but got %v", want, items)
+	}
+
+	h := heap.New(Natural[int]().Reverse().Less(), 3, 1, 4, 1, 5)
+	if got := h.Drain(); !reflect.DeepEqual(got, []int{5, 4, 3, 1, 1}) {
+		t.Errorf("Expected %v, but got %v", []int{5, 4, 3, 1, 1}, got)
+	}
+
+	sorted := []int{1, 3, 5, 7}
+	if i, found := Search(Natural[int](), sorted, 5); i != 2 || !found {
+		t.Errorf("Expected 2 true, but got %d %t", i, found)
+	}
+
+	if i, found := Search(Natural[int](), sorted, 4); i != 2 || found {
+		t.Errorf("Expected 2 false, but got %d %t", i, found)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"nested", Equal(map[string][]int{"a": {1, 2}}, map[string][]int{"a": {1, 2}}), true},
+		{"different", Equal([]person{{"a", 1}}, []person{{"a", 2}}), false},
+		{"equal method", Equal(now, now.Round(0).In(time.UTC)), true},
+		{"equal by", EqualBy(func(p person) int { return p.Age })(people[1], people[3]), true},
+		{"equal by different", EqualBy(func(p person) []string { return []string{p.Name} })(people[1], people[3]), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("Expected %t, but got %t", tt.want, tt.got)
+			}
+		})
+	}
+}
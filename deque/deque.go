@@ -0,0 +1,246 @@
+package deque
+
+import "iter"
+
+// minCap is the smallest backing array a non-empty Deque keeps.
+const minCap = 8
+
+// Deque is a double-ended queue backed by a growable ring buffer.
+//
+// Pushing and popping at either end run in amortized O(1) and At runs in
+// O(1). The backing array doubles when it is full and halves when it is a
+// quarter full, so popped elements do not pin a large array the way
+// reslicing does. The zero value is an empty deque ready to use. A Deque is
+// not safe for concurrent use.
+type Deque[T any] struct {
+	buf  []T
+	head int
+	n    int
+}
+
+// New returns a deque holding the given items, front first.
+func New[T any](items ...T) *Deque[T] {
+	d := WithCapacity[T](len(items))
+	d.n = copy(d.buf, items)
+
+	return d
+}
+
+// WithCapacity returns an empty deque that can hold at least capacity
+// elements before growing.
+func WithCapacity[T any](capacity int) *Deque[T] {
+	d := &Deque[T]{}
+	if capacity > 0 {
+		d.buf = make([]T, roundCap(capacity))
+	}
+
+	return d
+}
+
+// Len returns the number of elements.
+func (d *Deque[T]) Len() int {
+	return d.n
+}
+
+// Cap returns the number of elements the deque can hold before growing.
+func (d *Deque[T]) Cap() int {
+	return len(d.buf)
+}
+
+// PushBack appends v to the back.
+func (d *Deque[T]) PushBack(v T) {
+	d.grow()
+	d.buf[d.index(d.n)] = v
+	d.n++
+}
+
+// PushFront prepends v to the front.
+func (d *Deque[T]) PushFront(v T) {
+	d.grow()
+	d.head = d.index(len(d.buf) - 1)
+	d.buf[d.head] = v
+	d.n++
+}
+
+// PopBack removes and returns the back element.
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.n == 0 {
+		return zero, false
+	}
+
+	i := d.index(d.n - 1)
+	v := d.buf[i]
+	d.buf[i] = zero
+	d.n--
+	d.shrink()
+
+	return v, true
+}
+
+// PopFront removes and returns the front element.
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.n == 0 {
+		return zero, false
+	}
+
+	v := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = d.index(1)
+	d.n--
+	d.shrink()
+
+	return v, true
+}
+
+// Front returns the front element without removing it.
+func (d *Deque[T]) Front() (T, bool) {
+	return d.At(0)
+}
+
+// Back returns the back element without removing it.
+func (d *Deque[T]) Back() (T, bool) {
+	return d.At(d.n - 1)
+}
+
+// At returns the element at index i, counted from the front. Negative
+// indices count from the back, like slice.At.
+func (d *Deque[T]) At(i int) (T, bool) {
+	if i < 0 {
+		i += d.n
+	}
+
+	if i < 0 || i >= d.n {
+		var zero T
+		return zero, false
+	}
+
+	return d.buf[d.index(i)], true
+}
+
+// Set replaces the element at index i, counted from the front, and reports
+// whether i was in range. Negative indices count from the back.
+func (d *Deque[T]) Set(i int, v T) bool {
+	if i < 0 {
+		i += d.n
+	}
+
+	if i < 0 || i >= d.n {
+		return false
+	}
+
+	d.buf[d.index(i)] = v
+	return true
+}
+
+// Clear removes every element and releases the backing array.
+func (d *Deque[T]) Clear() {
+	*d = Deque[T]{}
+}
+
+// All returns an iterator over the indices and elements, front to back.
+func (d *Deque[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < d.n; i++ {
+			if !yield(i, d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Slice returns a copy of the elements, front to back. It is never nil.
+func (d *Deque[T]) Slice() []T {
+	result := make([]T, d.n)
+	d.copyTo(result)
+
+	return result
+}
+
+// Push appends values to the back, like Array.prototype.push in JavaScript,
+// and returns the new length.
+func (d *Deque[T]) Push(values ...T) int {
+	for _, v := range values {
+		d.PushBack(v)
+	}
+
+	return d.n
+}
+
+// Pop removes and returns the back element. It is an alias of PopBack.
+func (d *Deque[T]) Pop() (T, bool) {
+	return d.PopBack()
+}
+
+// Shift removes and returns the front element. It is an alias of PopFront.
+func (d *Deque[T]) Shift() (T, bool) {
+	return d.PopFront()
+}
+
+// Unshift prepends values to the front, keeping their order, like
+// Array.prototype.unshift in JavaScript, and returns the new length. Unlike
+// slice.Unshift it does not copy the existing elements.
+func (d *Deque[T]) Unshift(values ...T) int {
+	for i := len(values) - 1; i >= 0; i-- {
+		d.PushFront(values[i])
+	}
+
+	return d.n
+}
+
+// index maps a position counted from the front to an index of buf.
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) & (len(d.buf) - 1)
+}
+
+func (d *Deque[T]) copyTo(dst []T) {
+	if d.n == 0 {
+		return
+	}
+
+	if end := d.head + d.n; end <= len(d.buf) {
+		copy(dst, d.buf[d.head:end])
+		return
+	}
+
+	k := copy(dst, d.buf[d.head:])
+	copy(dst[k:], d.buf[:d.n-k])
+}
+
+func (d *Deque[T]) resize(capacity int) {
+	buf := make([]T, capacity)
+	d.copyTo(buf)
+	d.buf = buf
+	d.head = 0
+}
+
+func (d *Deque[T]) grow() {
+	if d.n < len(d.buf) {
+		return
+	}
+
+	if len(d.buf) == 0 {
+		d.buf = make([]T, minCap)
+		return
+	}
+
+	d.resize(len(d.buf) * 2)
+}
+
+func (d *Deque[T]) shrink() {
+	if len(d.buf) > minCap && d.n <= len(d.buf)/4 {
+		d.resize(len(d.buf) / 2)
+	}
+}
+
+// roundCap rounds n up to a power of two no smaller than minCap, so index
+// can wrap with a mask.
+func roundCap(n int) int {
+	c := minCap
+	for c < n {
+		c <<= 1
+	}
+
+	return c
+}
@@ -0,0 +1,182 @@
+package deque
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDeque(t *testing.T) {
+	var d Deque[int]
+
+	d.PushBack(2)
+	d.PushBack(3)
+	d.PushFront(1)
+	d.PushFront(0)
+
+	if got := d.Slice(); !reflect.DeepEqual(got, []int{0, 1, 2, 3}) {
+		t.Errorf("Slice() = %v, want %v", got, []int{0, 1, 2, 3})
+	}
+
+	if v, ok := d.At(-1); !ok || v != 3 {
+		t.Errorf("At(-1) = %v, %v, want 3, true", v, ok)
+	}
+
+	if _, ok := d.At(4); ok {
+		t.Errorf("Expected At(4) to be out of range")
+	}
+
+	d.Set(1, 10)
+	if v, _ := d.Front(); v != 0 {
+		t.Errorf("Front() = %v, want 0", v)
+	}
+
+	if v, _ := d.PopFront(); v != 0 {
+		t.Errorf("PopFront() = %v, want 0", v)
+	}
+
+	if v, _ := d.PopBack(); v != 3 {
+		t.Errorf("PopBack() = %v, want 3", v)
+	}
+
+	if got := d.Slice(); !reflect.DeepEqual(got, []int{10, 2}) {
+		t.Errorf("Slice() = %v, want %v", got, []int{10, 2})
+	}
+
+	d.Clear()
+	if _, ok := d.PopBack(); ok || d.Len() != 0 {
+		t.Errorf("Expected an empty deque after Clear")
+	}
+}
+
+func TestDequeGrowShrink(t *testing.T) {
+	d := New[int]()
+
+	// Alternate ends so the contents wrap around the backing array.
+	for i := 0; i < 100; i++ {
+		if i%2 == 0 {
+			d.PushBack(i)
+		} else {
+			d.PushFront(i)
+		}
+	}
+
+	if d.Len() != 100 || d.Cap() != 128 {
+		t.Errorf("Len() = %d, Cap() = %d, want 100, 128", d.Len(), d.Cap())
+	}
+
+	for i := 0; i < 100; i++ {
+		v, _ := d.At(i)
+		var want int
+		if i < 50 {
+			want = 99 - 2*i
+		} else {
+			want = 2 * (i - 50)
+		}
+
+		if v != want {
+			t.Fatalf("At(%d) = %d, want %d", i, v, want)
+		}
+	}
+
+	for d.Len() > 2 {
+		d.PopFront()
+	}
+
+	if d.Cap() != minCap {
+		t.Errorf("Cap() = %d after shrinking, want %d", d.Cap(), minCap)
+	}
+
+	if got := d.Slice(); !reflect.DeepEqual(got, []int{96, 98}) {
+		t.Errorf("Slice() = %v, want %v", got, []int{96, 98})
+	}
+}
+
+func TestDequeAliases(t *testing.T) {
+	d := New(3, 4)
+
+	if n := d.Unshift(1, 2); n != 4 {
+		t.Errorf("Unshift() = %d, want 4", n)
+	}
+
+	if n := d.Push(5, 6); n != 6 {
+		t.Errorf("Push() = %d, want 6", n)
+	}
+
+	first, _ := d.Shift()
+	last, _ := d.Pop()
+	if first != 1 || last != 6 {
+		t.Errorf("Shift(), Pop() = %d, %d, want 1, 6", first, last)
+	}
+
+	got := make([]int, 0)
+	for i, v := range d.All() {
+		if i >= 3 {
+			break
+		}
+		got = append(got, v)
+	}
+
+	if !reflect.DeepEqual(got, []int{2, 3, 4}) {
+		t.Errorf("All() = %v, want %v", got, []int{2, 3, 4})
+	}
+}
+
+func TestRingBuffer(t *testing.T) {
+	t.Run("overwrite", func(t *testing.T) {
+		r := NewRingBuffer[int](3, Overwrite)
+		for i := 1; i <= 5; i++ {
+			if err := r.Push(i); err != nil {
+				t.Fatalf("Push(%d) = %v", i, err)
+			}
+		}
+
+		if got := r.Slice(); !reflect.DeepEqual(got, []int{3, 4, 5}) {
+			t.Errorf("Slice() = %v, want %v", got, []int{3, 4, 5})
+		}
+
+		if v, _ := r.At(-1); v != 5 {
+			t.Errorf("At(-1) = %v, want 5", v)
+		}
+
+		if v, _ := r.Pop(); v != 3 {
+			t.Errorf("Pop() = %v, want 3", v)
+		}
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		r := NewRingBuffer[string](2, Reject)
+		r.Push("a")
+		r.Push("b")
+
+		if err := r.Push("c"); !errors.Is(err, ErrFull) {
+			t.Errorf("Push() = %v, want ErrFull", err)
+		}
+
+		if !r.Full() {
+			t.Errorf("Expected the buffer to be full")
+		}
+
+		if v, _ := r.Peek(); v != "a" {
+			t.Errorf("Peek() = %v, want a", v)
+		}
+
+		r.Pop()
+		r.Push("c")
+		if got := r.Slice(); !reflect.DeepEqual(got, []string{"b", "c"}) {
+			t.Errorf("Slice() = %v, want %v", got, []string{"b", "c"})
+		}
+
+		r.Clear()
+		if r.Len() != 0 || r.Cap() != 2 {
+			t.Errorf("Len() = %d, Cap() = %d after Clear, want 0, 2", r.Len(), r.Cap())
+		}
+	})
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected NewRingBuffer(0) to panic")
+		}
+	}()
+	NewRingBuffer[int](0, Overwrite)
+}
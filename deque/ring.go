@@ -0,0 +1,143 @@
+package deque
+
+import (
+	"errors"
+	"iter"
+)
+
+// ErrFull is returned by RingBuffer.Push in Reject mode when the buffer is
+// full.
+var ErrFull = errors.New("deque: ring buffer is full")
+
+// Mode decides what a full RingBuffer does with a new element.
+type Mode int
+
+const (
+	// Overwrite drops the oldest element to make room for the new one.
+	Overwrite Mode = iota
+	// Reject keeps the buffer as is and makes Push return ErrFull.
+	Reject
+)
+
+// RingBuffer is a FIFO queue with a fixed capacity. It never allocates after
+// construction, which makes it a good fit for bounded histories and
+// producer/consumer buffers. A RingBuffer is not safe for concurrent use.
+type RingBuffer[T any] struct {
+	buf  []T
+	head int
+	n    int
+	mode Mode
+}
+
+// NewRingBuffer returns an empty ring buffer holding at most capacity
+// elements. It panics if capacity is less than 1.
+func NewRingBuffer[T any](capacity int, mode Mode) *RingBuffer[T] {
+	if capacity < 1 {
+		panic("deque: ring buffer capacity must be at least 1")
+	}
+
+	return &RingBuffer[T]{
+		buf:  make([]T, capacity),
+		mode: mode,
+	}
+}
+
+// Len returns the number of elements.
+func (r *RingBuffer[T]) Len() int {
+	return r.n
+}
+
+// Cap returns the fixed capacity.
+func (r *RingBuffer[T]) Cap() int {
+	return len(r.buf)
+}
+
+// Full reports whether the buffer holds Cap elements.
+func (r *RingBuffer[T]) Full() bool {
+	return r.n == len(r.buf)
+}
+
+// Push appends v as the newest element. When the buffer is full it either
+// drops the oldest element or returns ErrFull, depending on the mode.
+func (r *RingBuffer[T]) Push(v T) error {
+	if r.n < len(r.buf) {
+		r.buf[r.index(r.n)] = v
+		r.n++
+		return nil
+	}
+
+	if r.mode == Reject {
+		return ErrFull
+	}
+
+	r.buf[r.head] = v
+	r.head = r.index(1)
+
+	return nil
+}
+
+// Pop removes and returns the oldest element.
+func (r *RingBuffer[T]) Pop() (T, bool) {
+	var zero T
+	if r.n == 0 {
+		return zero, false
+	}
+
+	v := r.buf[r.head]
+	r.buf[r.head] = zero
+	r.head = r.index(1)
+	r.n--
+
+	return v, true
+}
+
+// Peek returns the oldest element without removing it.
+func (r *RingBuffer[T]) Peek() (T, bool) {
+	return r.At(0)
+}
+
+// At returns the element at index i, counted from the oldest. Negative
+// indices count from the newest.
+func (r *RingBuffer[T]) At(i int) (T, bool) {
+	if i < 0 {
+		i += r.n
+	}
+
+	if i < 0 || i >= r.n {
+		var zero T
+		return zero, false
+	}
+
+	return r.buf[r.index(i)], true
+}
+
+// Clear removes every element, keeping the capacity.
+func (r *RingBuffer[T]) Clear() {
+	clear(r.buf)
+	r.head, r.n = 0, 0
+}
+
+// All returns an iterator over the indices and elements, oldest first.
+func (r *RingBuffer[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < r.n; i++ {
+			if !yield(i, r.buf[r.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Slice returns a copy of the elements, oldest first. It is never nil.
+func (r *RingBuffer[T]) Slice() []T {
+	result := make([]T, 0, r.n)
+	for i := 0; i < r.n; i++ {
+		result = append(result, r.buf[r.index(i)])
+	}
+
+	return result
+}
+
+func (r *RingBuffer[T]) index(i int) int {
+	return (r.head + i) % len(r.buf)
+}
@@ -0,0 +1,416 @@
+package diff
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrConflict is returned when a patch does not apply to the value it is
+// applied to, for example when it removes a key that is not there.
+var ErrConflict = errors.New("diff: patch does not apply")
+
+// ErrDuplicateKey is returned when two elements of a slice diffed or patched
+// by key share the same key, since only one of them could be tracked.
+var ErrDuplicateKey = errors.New("diff: duplicate key")
+
+// Op is the kind of a change.
+type Op int
+
+const (
+	// Add inserts a value that was not there.
+	Add Op = iota
+	// Remove deletes a value.
+	Remove
+	// Replace changes a value in place.
+	Replace
+)
+
+// String returns the JSON Patch name of the operation.
+func (o Op) String() string {
+	switch o {
+	case Add:
+		return "add"
+	case Remove:
+		return "remove"
+	case Replace:
+		return "replace"
+	}
+
+	return fmt.Sprintf("Op(%d)", int(o))
+}
+
+// Change is a single difference. Key is the map key, the key returned by
+// the key function, or the slice index. Old is set for Remove and Replace,
+// New for Add and Replace.
+type Change[K, V any] struct {
+	Op  Op
+	Key K
+	Old V
+	New V
+}
+
+// String implements fmt.Stringer.
+func (c Change[K, V]) String() string {
+	switch c.Op {
+	case Add:
+		return fmt.Sprintf("+ %v: %v", c.Key, c.New)
+	case Remove:
+		return fmt.Sprintf("- %v: %v", c.Key, c.Old)
+	}
+
+	return fmt.Sprintf("~ %v: %v -> %v", c.Key, c.Old, c.New)
+}
+
+func (c Change[K, V]) invert() Change[K, V] {
+	switch c.Op {
+	case Add:
+		return Change[K, V]{Op: Remove, Key: c.Key, Old: c.New}
+	case Remove:
+		return Change[K, V]{Op: Add, Key: c.Key, New: c.Old}
+	}
+
+	return Change[K, V]{Op: Replace, Key: c.Key, Old: c.New, New: c.Old}
+}
+
+// JSONOp is one operation of an RFC 6902 JSON Patch document.
+type JSONOp struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value"`
+}
+
+// MarshalJSON implements json.Marshaler. The value is left out of remove
+// operations only, since add and replace require it even when it is null.
+func (o JSONOp) MarshalJSON() ([]byte, error) {
+	if o.Op == Remove.String() {
+		return json.Marshal(struct {
+			Op   string `json:"op"`
+			Path string `json:"path"`
+		}{o.Op, o.Path})
+	}
+
+	// op has the fields of JSONOp but not this method.
+	type op JSONOp
+
+	return json.Marshal(op(o))
+}
+
+func jsonOp[K, V any](prefix string, c Change[K, V]) JSONOp {
+	op := JSONOp{Op: c.Op.String(), Path: prefix + "/" + escape(fmt.Sprint(c.Key))}
+	if c.Op != Remove {
+		op.Value = c.New
+	}
+
+	return op
+}
+
+// escape encodes a reference token as described in RFC 6901.
+func escape(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
+
+// Patch is a list of keyed changes, sorted by key. It is produced by Maps,
+// MapsFunc and SlicesBy.
+type Patch[K comparable, V any] []Change[K, V]
+
+// Maps returns the changes that turn from into to.
+func Maps[K cmp.Ordered, V comparable](from, to map[K]V) Patch[K, V] {
+	return MapsFunc(func(a, b V) bool { return a == b }, from, to)
+}
+
+// MapsFunc returns the changes that turn from into to, comparing values with
+// equal.
+func MapsFunc[K cmp.Ordered, V any](equal func(a, b V) bool, from, to map[K]V) Patch[K, V] {
+	result := make(Patch[K, V], 0)
+
+	for k, o := range from {
+		n, ok := to[k]
+		switch {
+		case !ok:
+			result = append(result, Change[K, V]{Op: Remove, Key: k, Old: o})
+		case !equal(o, n):
+			result = append(result, Change[K, V]{Op: Replace, Key: k, Old: o, New: n})
+		}
+	}
+
+	for k, n := range to {
+		if _, ok := from[k]; !ok {
+			result = append(result, Change[K, V]{Op: Add, Key: k, New: n})
+		}
+	}
+
+	slices.SortFunc(result, func(a, b Change[K, V]) int {
+		return cmp.Compare(a.Key, b.Key)
+	})
+
+	return result
+}
+
+// SlicesBy returns the changes between two slices whose elements are
+// identified by key, such as records with an ID. Elements are compared with
+// equal; their positions are ignored. It returns ErrDuplicateKey when two
+// elements of the same slice share a key.
+func SlicesBy[T any, K cmp.Ordered](key func(T) K, equal func(a, b T) bool, from, to []T) (Patch[K, T], error) {
+	fromIndex, err := index(key, from)
+	if err != nil {
+		return nil, err
+	}
+
+	toIndex, err := index(key, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return MapsFunc(equal, fromIndex, toIndex), nil
+}
+
+func index[T any, K comparable](key func(T) K, items []T) (map[K]T, error) {
+	result := make(map[K]T, len(items))
+	for _, v := range items {
+		k := key(v)
+		if _, ok := result[k]; ok {
+			return nil, fmt.Errorf("%w: %v", ErrDuplicateKey, k)
+		}
+
+		result[k] = v
+	}
+
+	return result, nil
+}
+
+// Apply returns a copy of m with the changes applied. It returns ErrConflict
+// when an added key is already present or a removed or replaced key is
+// missing.
+func (p Patch[K, V]) Apply(m map[K]V) (map[K]V, error) {
+	result := make(map[K]V, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+
+	for _, c := range p {
+		_, ok := result[c.Key]
+		if ok == (c.Op == Add) {
+			return m, fmt.Errorf("%w: %s %v", ErrConflict, c.Op, c.Key)
+		}
+
+		if c.Op == Remove {
+			delete(result, c.Key)
+		} else {
+			result[c.Key] = c.New
+		}
+	}
+
+	return result, nil
+}
+
+// ApplyBy returns a copy of items with the changes applied, locating the
+// elements with key. Replaced elements keep their position and added ones
+// are appended in key order. It returns ErrDuplicateKey when two elements of
+// items share a key.
+func (p Patch[K, V]) ApplyBy(key func(V) K, items []V) ([]V, error) {
+	positions := make(map[K]int, len(items))
+	for i, v := range items {
+		k := key(v)
+		if _, ok := positions[k]; ok {
+			return items, fmt.Errorf("%w: %v", ErrDuplicateKey, k)
+		}
+
+		positions[k] = i
+	}
+
+	result := slices.Clone(items)
+	removed := make(map[int]bool)
+
+	for _, c := range p {
+		i, ok := positions[c.Key]
+		if ok == (c.Op == Add) {
+			return items, fmt.Errorf("%w: %s %v", ErrConflict, c.Op, c.Key)
+		}
+
+		switch c.Op {
+		case Add:
+			positions[c.Key] = len(result)
+			result = append(result, c.New)
+		case Remove:
+			delete(positions, c.Key)
+			removed[i] = true
+		case Replace:
+			result[i] = c.New
+		}
+	}
+
+	kept := result[:0]
+	for i, v := range result {
+		if !removed[i] {
+			kept = append(kept, v)
+		}
+	}
+
+	return kept, nil
+}
+
+// Invert returns the patch that undoes p.
+func (p Patch[K, V]) Invert() Patch[K, V] {
+	result := make(Patch[K, V], len(p))
+	for i, c := range p {
+		result[i] = c.invert()
+	}
+
+	return result
+}
+
+// JSONPatch renders p as RFC 6902 operations on the object at prefix, which
+// is a JSON Pointer such as "" for the document root or "/labels".
+func (p Patch[K, V]) JSONPatch(prefix string) []JSONOp {
+	result := make([]JSONOp, len(p))
+	for i, c := range p {
+		result[i] = jsonOp(prefix, c)
+	}
+
+	return result
+}
+
+// Script is an edit script turning one slice into another. Changes are
+// meant to be applied in order, and each Key is the index in the slice as
+// left by the previous changes, as in JSON Patch.
+type Script[T any] []Change[int, T]
+
+// Slices returns a minimal edit script turning from into to, based on their
+// longest common subsequence.
+func Slices[T comparable](from, to []T) Script[T] {
+	return SlicesFunc(func(a, b T) bool { return a == b }, from, to)
+}
+
+// SlicesFunc is like Slices but compares elements with equal.
+//
+// It runs in O(n*m) time and memory, after trimming the common prefix and
+// suffix, where n and m are the lengths of the remaining parts.
+func SlicesFunc[T any](equal func(a, b T) bool, from, to []T) Script[T] {
+	prefix := 0
+	for prefix < len(from) && prefix < len(to) && equal(from[prefix], to[prefix]) {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(from)-prefix && suffix < len(to)-prefix &&
+		equal(from[len(from)-1-suffix], to[len(to)-1-suffix]) {
+		suffix++
+	}
+
+	a, b := from[prefix:len(from)-suffix], to[prefix:len(to)-suffix]
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if equal(a[i], b[j]) {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	result := make(Script[T], 0)
+	pos := prefix
+	var removed, added []T
+
+	// flush turns a run of removals followed by a run of additions into
+	// replacements for the overlapping part.
+	flush := func() {
+		n := min(len(removed), len(added))
+		for k := 0; k < n; k++ {
+			result = append(result, Change[int, T]{Op: Replace, Key: pos, Old: removed[k], New: added[k]})
+			pos++
+		}
+
+		for _, v := range removed[n:] {
+			result = append(result, Change[int, T]{Op: Remove, Key: pos, Old: v})
+		}
+
+		for _, v := range added[n:] {
+			result = append(result, Change[int, T]{Op: Add, Key: pos, New: v})
+			pos++
+		}
+
+		removed, added = removed[:0], added[:0]
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && equal(a[i], b[j]):
+			flush()
+			pos++
+			i++
+			j++
+		case j == len(b) || i < len(a) && lcs[i+1][j] >= lcs[i][j+1]:
+			removed = append(removed, a[i])
+			i++
+		default:
+			added = append(added, b[j])
+			j++
+		}
+	}
+	flush()
+
+	return result
+}
+
+// Apply returns a copy of items with the script applied. It returns
+// ErrConflict when an index is out of range.
+func (s Script[T]) Apply(items []T) ([]T, error) {
+	result := slices.Clone(items)
+
+	for _, c := range s {
+		limit := len(result)
+		if c.Op == Add {
+			limit++
+		}
+
+		if c.Key < 0 || c.Key >= limit {
+			return items, fmt.Errorf("%w: %s at index %d of %d", ErrConflict, c.Op, c.Key, len(result))
+		}
+
+		switch c.Op {
+		case Add:
+			result = slices.Insert(result, c.Key, c.New)
+		case Remove:
+			result = slices.Delete(result, c.Key, c.Key+1)
+		case Replace:
+			result[c.Key] = c.New
+		}
+	}
+
+	return result, nil
+}
+
+// Invert returns the script that undoes s.
+func (s Script[T]) Invert() Script[T] {
+	result := make(Script[T], len(s))
+	for i, c := range s {
+		result[len(s)-1-i] = c.invert()
+	}
+
+	return result
+}
+
+// JSONPatch renders s as RFC 6902 operations on the array at prefix, which
+// is a JSON Pointer such as "" for the document root or "/tags".
+func (s Script[T]) JSONPatch(prefix string) []JSONOp {
+	result := make([]JSONOp, len(s))
+	for i, c := range s {
+		result[i] = jsonOp(prefix, c)
+	}
+
+	return result
+}
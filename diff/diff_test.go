@@ -0,0 +1,187 @@
+package diff
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMaps(t *testing.T) {
+	from := map[string]int{"a": 1, "b": 2, "c": 3}
+	to := map[string]int{"a": 1, "b": 20, "d": 4}
+
+	p := Maps(from, to)
+	expected := Patch[string, int]{
+		{Op: Replace, Key: "b", Old: 2, New: 20},
+		{Op: Remove, Key: "c", Old: 3},
+		{Op: Add, Key: "d", New: 4},
+	}
+
+	if !reflect.DeepEqual(p, expected) {
+		t.Errorf("Maps() = %v, want %v", p, expected)
+	}
+
+	applied, err := p.Apply(from)
+	if err != nil || !reflect.DeepEqual(applied, to) {
+		t.Errorf("Apply() = %v, %v, want %v", applied, err, to)
+	}
+
+	reverted, err := p.Invert().Apply(to)
+	if err != nil || !reflect.DeepEqual(reverted, from) {
+		t.Errorf("Invert().Apply() = %v, %v, want %v", reverted, err, from)
+	}
+
+	if _, err := p.Apply(to); !errors.Is(err, ErrConflict) {
+		t.Errorf("Expected ErrConflict, but got %v", err)
+	}
+
+	if from["b"] != 2 {
+		t.Errorf("Expected Apply not to modify its input")
+	}
+}
+
+type user struct {
+	ID   int
+	Name string
+}
+
+func TestSlicesBy(t *testing.T) {
+	id := func(u user) int { return u.ID }
+	equal := func(a, b user) bool { return a == b }
+
+	from := []user{{1, "ann"}, {2, "bob"}, {3, "cid"}}
+	to := []user{{3, "cid"}, {1, "anne"}, {4, "dan"}}
+
+	p, err := SlicesBy(id, equal, from, to)
+	if err != nil {
+		t.Fatalf("SlicesBy() error = %v", err)
+	}
+
+	expected := Patch[int, user]{
+		{Op: Replace, Key: 1, Old: user{1, "ann"}, New: user{1, "anne"}},
+		{Op: Remove, Key: 2, Old: user{2, "bob"}},
+		{Op: Add, Key: 4, New: user{4, "dan"}},
+	}
+
+	if !reflect.DeepEqual(p, expected) {
+		t.Errorf("SlicesBy() = %v, want %v", p, expected)
+	}
+
+	got, err := p.ApplyBy(id, from)
+	want := []user{{1, "anne"}, {3, "cid"}, {4, "dan"}}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("ApplyBy() = %v, %v, want %v", got, err, want)
+	}
+}
+
+func TestSlicesByDuplicateKeys(t *testing.T) {
+	id := func(u user) int { return u.ID }
+	equal := func(a, b user) bool { return a == b }
+
+	unique := []user{{1, "ann"}, {2, "bob"}}
+	duplicated := []user{{1, "ann"}, {2, "bob"}, {1, "amy"}}
+
+	tests := []struct {
+		name     string
+		from, to []user
+	}{
+		{"duplicate in from", duplicated, unique},
+		{"duplicate in to", unique, duplicated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if p, err := SlicesBy(id, equal, tt.from, tt.to); !errors.Is(err, ErrDuplicateKey) || p != nil {
+				t.Errorf("Expected ErrDuplicateKey, but got %v, %v", p, err)
+			}
+		})
+	}
+
+	p := Patch[int, user]{{Op: Replace, Key: 1, Old: user{1, "ann"}, New: user{1, "anne"}}}
+	if got, err := p.ApplyBy(id, duplicated); !errors.Is(err, ErrDuplicateKey) || !reflect.DeepEqual(got, duplicated) {
+		t.Errorf("Expected ErrDuplicateKey and the input back, but got %v, %v", got, err)
+	}
+}
+
+func TestSlices(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+	}{
+		{"equal", "abc", "abc"},
+		{"empty to full", "", "abc"},
+		{"full to empty", "abc", ""},
+		{"insert", "ac", "abc"},
+		{"delete", "abc", "ac"},
+		{"replace", "abc", "axc"},
+		{"mixed", "abcabba", "cbabac"},
+		{"disjoint", "abc", "xyzw"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to := strings.Split(tt.from, ""), strings.Split(tt.to, "")
+			s := Slices(from, to)
+
+			got, err := s.Apply(from)
+			if err != nil || !reflect.DeepEqual(got, to) {
+				t.Errorf("Apply() = %v, %v, want %v (script %v)", got, err, to, s)
+			}
+
+			back, err := s.Invert().Apply(to)
+			if err != nil || !reflect.DeepEqual(back, from) {
+				t.Errorf("Invert().Apply() = %v, %v, want %v (script %v)", back, err, from, s)
+			}
+		})
+	}
+}
+
+func TestSlicesMinimal(t *testing.T) {
+	s := Slices([]int{1, 2, 3, 4}, []int{1, 9, 3, 4, 5})
+	expected := Script[int]{
+		{Op: Replace, Key: 1, Old: 2, New: 9},
+		{Op: Add, Key: 4, New: 5},
+	}
+
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Slices() = %v, want %v", s, expected)
+	}
+
+	if _, err := s.Apply([]int{1}); !errors.Is(err, ErrConflict) {
+		t.Errorf("Expected ErrConflict, but got %v", err)
+	}
+}
+
+func TestJSONPatch(t *testing.T) {
+	p := Maps(map[string]int{"a/b": 1, "c": 2}, map[string]int{"c": 3, "d~": 0})
+
+	data, err := json.Marshal(p.JSONPatch("/labels"))
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	expected := `[{"op":"remove","path":"/labels/a~1b"},` +
+		`{"op":"replace","path":"/labels/c","value":3},` +
+		`{"op":"add","path":"/labels/d~0","value":0}]`
+
+	if string(data) != expected {
+		t.Errorf("JSONPatch() = %s, want %s", data, expected)
+	}
+
+	data, _ = json.Marshal(Slices([]string{"x"}, []string{"x", "y"}).JSONPatch(""))
+	if string(data) != `[{"op":"add","path":"/1","value":"y"}]` {
+		t.Errorf("JSONPatch() = %s", data)
+	}
+
+	data, _ = json.Marshal(Maps(map[string]any{"a": 1, "c": 2}, map[string]any{"a": nil, "b": nil}).JSONPatch(""))
+	expected = `[{"op":"replace","path":"/a","value":null},` +
+		`{"op":"add","path":"/b","value":null},` +
+		`{"op":"remove","path":"/c"}]`
+
+	if string(data) != expected {
+		t.Errorf("JSONPatch() = %s, want %s", data, expected)
+	}
+}
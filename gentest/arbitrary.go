@@ -0,0 +1,216 @@
+package gentest
+
+import (
+	"math/rand/v2"
+	"reflect"
+)
+
+// Arbitrary generates values of any type T by reflection: booleans, numbers,
+// strings, slices, arrays, maps, pointers and the exported fields of structs,
+// recursively. Unexported fields, interfaces, channels and functions are
+// left at their zero value. Nested values get a smaller size hint, so
+// recursive types stay finite.
+//
+// Failing values shrink field by field towards the zero value.
+func Arbitrary[T any]() Gen[T] {
+	typ := reflect.TypeFor[T]()
+
+	return Gen[T]{
+		Generate: func(r *rand.Rand, size int) T {
+			var result T
+			reflect.ValueOf(&result).Elem().Set(arbitrary(r, typ, size))
+
+			return result
+		},
+		Shrink: func(v T) []T {
+			candidates := shrinkValue(reflect.ValueOf(&v).Elem())
+
+			result := make([]T, 0, len(candidates))
+			for _, c := range candidates {
+				var x T
+				reflect.ValueOf(&x).Elem().Set(c)
+				result = append(result, x)
+			}
+
+			return result
+		},
+	}
+}
+
+func arbitrary(r *rand.Rand, typ reflect.Type, size int) reflect.Value {
+	v := reflect.New(typ).Elem()
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		v.SetBool(r.IntN(2) == 1)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n := int64(r.IntN(2*size+1) - size)
+		if !v.OverflowInt(n) {
+			v.SetInt(n)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n := uint64(r.IntN(size + 1))
+		if !v.OverflowUint(n) {
+			v.SetUint(n)
+		}
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat((r.Float64()*2 - 1) * float64(size))
+	case reflect.Complex64, reflect.Complex128:
+		v.SetComplex(complex((r.Float64()*2-1)*float64(size), (r.Float64()*2-1)*float64(size)))
+	case reflect.String:
+		v.SetString(String().Generate(r, size))
+	case reflect.Slice:
+		n := r.IntN(size + 1)
+		v.Set(reflect.MakeSlice(typ, n, n))
+
+		for i := 0; i < n; i++ {
+			v.Index(i).Set(arbitrary(r, typ.Elem(), size/2))
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			v.Index(i).Set(arbitrary(r, typ.Elem(), size/2))
+		}
+	case reflect.Map:
+		n := r.IntN(size + 1)
+		v.Set(reflect.MakeMapWithSize(typ, n))
+
+		for i := 0; i < n; i++ {
+			v.SetMapIndex(arbitrary(r, typ.Key(), size/2), arbitrary(r, typ.Elem(), size/2))
+		}
+	case reflect.Pointer:
+		if size > 0 && r.IntN(4) > 0 {
+			v.Set(reflect.New(typ.Elem()))
+			v.Elem().Set(arbitrary(r, typ.Elem(), size/2))
+		}
+	case reflect.Struct:
+		for i := 0; i < typ.NumField(); i++ {
+			if typ.Field(i).IsExported() {
+				v.Field(i).Set(arbitrary(r, typ.Field(i).Type, size))
+			}
+		}
+	}
+
+	return v
+}
+
+// shrinkValue returns simpler copies of v; v itself is never modified.
+func shrinkValue(v reflect.Value) []reflect.Value {
+	typ := v.Type()
+	result := make([]reflect.Value, 0)
+
+	with := func(set func(reflect.Value)) {
+		c := reflect.New(typ).Elem()
+		c.Set(v)
+		set(c)
+		result = append(result, c)
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			with(func(c reflect.Value) { c.SetBool(false) })
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		for _, n := range shrinkInt(int(v.Int())) {
+			with(func(c reflect.Value) { c.SetInt(int64(n)) })
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if n := v.Uint(); n != 0 {
+			with(func(c reflect.Value) { c.SetUint(0) })
+			if n > 1 {
+				with(func(c reflect.Value) { c.SetUint(n / 2) })
+			}
+		}
+	case reflect.Float32, reflect.Float64:
+		for _, f := range Float64().shrink(v.Float()) {
+			with(func(c reflect.Value) { c.SetFloat(f) })
+		}
+	case reflect.Complex64, reflect.Complex128:
+		if v.Complex() != 0 {
+			with(func(c reflect.Value) { c.SetComplex(0) })
+		}
+	case reflect.String:
+		for _, s := range String().shrink(v.String()) {
+			with(func(c reflect.Value) { c.SetString(s) })
+		}
+	case reflect.Slice:
+		if v.Len() == 0 {
+			if !v.IsNil() {
+				result = append(result, reflect.Zero(typ))
+			}
+
+			break
+		}
+
+		items := make([]reflect.Value, v.Len())
+		for i := range items {
+			items[i] = v.Index(i)
+		}
+
+		for _, shrunk := range shrinkSlice(items, shrinkValue) {
+			c := reflect.MakeSlice(typ, len(shrunk), len(shrunk))
+			for i, item := range shrunk {
+				c.Index(i).Set(item)
+			}
+
+			result = append(result, c)
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			for _, item := range shrinkValue(v.Index(i)) {
+				with(func(c reflect.Value) { c.Index(i).Set(item) })
+			}
+		}
+	case reflect.Map:
+		if v.Len() == 0 {
+			break
+		}
+
+		result = append(result, reflect.MakeMap(typ))
+		for _, k := range v.MapKeys() {
+			without := copyMapValue(v)
+			without.SetMapIndex(k, reflect.Value{})
+			result = append(result, without)
+		}
+
+		for _, k := range v.MapKeys() {
+			for _, item := range shrinkValue(v.MapIndex(k)) {
+				shrunk := copyMapValue(v)
+				shrunk.SetMapIndex(k, item)
+				result = append(result, shrunk)
+			}
+		}
+	case reflect.Pointer:
+		if v.IsNil() {
+			break
+		}
+
+		result = append(result, reflect.Zero(typ))
+		for _, item := range shrinkValue(v.Elem()) {
+			c := reflect.New(typ.Elem())
+			c.Elem().Set(item)
+			result = append(result, c)
+		}
+	case reflect.Struct:
+		for i := 0; i < typ.NumField(); i++ {
+			if !typ.Field(i).IsExported() {
+				continue
+			}
+
+			for _, field := range shrinkValue(v.Field(i)) {
+				with(func(c reflect.Value) { c.Field(i).Set(field) })
+			}
+		}
+	}
+
+	return result
+}
+
+func copyMapValue(m reflect.Value) reflect.Value {
+	result := reflect.MakeMapWithSize(m.Type(), m.Len())
+	for iter := m.MapRange(); iter.Next(); {
+		result.SetMapIndex(iter.Key(), iter.Value())
+	}
+
+	return result
+}
@@ -0,0 +1,116 @@
+package gentest
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+const (
+	defaultRuns    = 100
+	defaultMaxSize = 100
+	maxShrinks     = 1000
+)
+
+type config struct {
+	runs    int
+	maxSize int
+	seed    uint64
+}
+
+// Option configures Check and the law checkers.
+type Option func(*config)
+
+// WithRuns sets how many values are tried. The default is 100.
+func WithRuns(n int) Option {
+	return func(c *config) {
+		c.runs = n
+	}
+}
+
+// WithMaxSize sets the size hint given to generators on the last run. The
+// default is 100.
+func WithMaxSize(n int) Option {
+	return func(c *config) {
+		c.maxSize = n
+	}
+}
+
+// WithSeed fixes the seed of the random generator, to replay a failure
+// reported by Check. By default a new seed is drawn for every call.
+func WithSeed(seed uint64) Option {
+	return func(c *config) {
+		c.seed = seed
+	}
+}
+
+func newConfig(opts []Option) config {
+	c := config{
+		runs:    defaultRuns,
+		maxSize: defaultMaxSize,
+		seed:    rand.Uint64(),
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return c
+}
+
+// Check tests that prop holds for values drawn from g. The size hint grows
+// from 0 to the maximum size over the runs, so small counterexamples are
+// found first.
+//
+// On the first failing value Check shrinks it to a minimal counterexample,
+// reports it along with the seed through t.Errorf and returns false. Pass
+// the seed to WithSeed to replay the failure.
+func Check[T any](t testing.TB, g Gen[T], prop func(T) bool, opts ...Option) bool {
+	t.Helper()
+
+	c := newConfig(opts)
+	r := rand.New(rand.NewPCG(c.seed, c.seed))
+
+	for i := 0; i < c.runs; i++ {
+		size := 0
+		if c.runs > 1 {
+			size = i * c.maxSize / (c.runs - 1)
+		}
+
+		v := g.Generate(r, size)
+		if prop(v) {
+			continue
+		}
+
+		shrunk, steps := shrinkFailure(g, prop, v)
+		t.Errorf("gentest: property failed on run %d (seed %d)\ncounterexample: %#v\nshrunk %d times from: %#v",
+			i+1, c.seed, shrunk, steps, v)
+
+		return false
+	}
+
+	return true
+}
+
+// shrinkFailure greedily replaces v by its first simpler candidate that
+// still fails prop, until none does.
+func shrinkFailure[T any](g Gen[T], prop func(T) bool, v T) (T, int) {
+	steps := 0
+
+	for steps < maxShrinks {
+		shrunk := false
+
+		for _, c := range g.shrink(v) {
+			if !prop(c) {
+				v, shrunk = c, true
+				steps++
+				break
+			}
+		}
+
+		if !shrunk {
+			break
+		}
+	}
+
+	return v, steps
+}
@@ -0,0 +1,318 @@
+package gentest
+
+import (
+	"math/rand/v2"
+
+	"github.com/cirius-go/generic/tuple"
+)
+
+// Gen produces random values of type T and knows how to make a failing
+// value smaller.
+//
+// Generate receives a size hint that grows over the runs of Check; it bounds
+// the magnitude of numbers and the length of collections. Shrink returns
+// candidates that are strictly simpler than its argument, simplest first,
+// or nil when the value cannot be simplified.
+type Gen[T any] struct {
+	Generate func(r *rand.Rand, size int) T
+	Shrink   func(v T) []T
+}
+
+func (g Gen[T]) shrink(v T) []T {
+	if g.Shrink == nil {
+		return nil
+	}
+
+	return g.Shrink(v)
+}
+
+// Const always generates v.
+func Const[T any](v T) Gen[T] {
+	return Gen[T]{
+		Generate: func(*rand.Rand, int) T { return v },
+	}
+}
+
+// OneOf generates one of values, shrinking towards the first one. It panics
+// if values is empty.
+func OneOf[T any](values ...T) Gen[T] {
+	if len(values) == 0 {
+		panic("gentest: OneOf needs at least one value")
+	}
+
+	return Gen[T]{
+		Generate: func(r *rand.Rand, _ int) T { return values[r.IntN(len(values))] },
+		Shrink: func(T) []T {
+			return values[:1]
+		},
+	}
+}
+
+// Bool generates booleans, shrinking true to false.
+func Bool() Gen[bool] {
+	return Gen[bool]{
+		Generate: func(r *rand.Rand, _ int) bool { return r.IntN(2) == 1 },
+		Shrink: func(v bool) []bool {
+			if v {
+				return []bool{false}
+			}
+
+			return nil
+		},
+	}
+}
+
+// Int generates integers in [-size, size], shrinking towards 0.
+func Int() Gen[int] {
+	return Gen[int]{
+		Generate: func(r *rand.Rand, size int) int { return r.IntN(2*size+1) - size },
+		Shrink:   shrinkInt,
+	}
+}
+
+// IntRange generates integers in [lo, hi], shrinking towards lo. It panics
+// if hi < lo.
+func IntRange(lo, hi int) Gen[int] {
+	if hi < lo {
+		panic("gentest: IntRange needs lo <= hi")
+	}
+
+	return Gen[int]{
+		Generate: func(r *rand.Rand, _ int) int { return lo + r.IntN(hi-lo+1) },
+		Shrink: func(v int) []int {
+			result := make([]int, 0, 2)
+			for _, c := range shrinkInt(v - lo) {
+				result = append(result, lo+c)
+			}
+
+			return result
+		},
+	}
+}
+
+func shrinkInt(v int) []int {
+	if v == 0 {
+		return nil
+	}
+
+	result := []int{0}
+	if half := v / 2; half != 0 {
+		result = append(result, half)
+	}
+
+	if step := v - v/abs(v); step != 0 && step != v/2 {
+		result = append(result, step)
+	}
+
+	return result
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
+
+// Float64 generates floats in [-size, size), shrinking towards 0 and whole
+// numbers.
+func Float64() Gen[float64] {
+	return Gen[float64]{
+		Generate: func(r *rand.Rand, size int) float64 { return (r.Float64()*2 - 1) * float64(size) },
+		Shrink: func(v float64) []float64 {
+			if v == 0 {
+				return nil
+			}
+
+			result := []float64{0}
+			if whole := float64(int64(v)); whole != v && whole != 0 {
+				result = append(result, whole)
+			}
+
+			return append(result, v/2)
+		},
+	}
+}
+
+// String generates printable ASCII strings of at most size characters,
+// shrinking by dropping characters.
+func String() Gen[string] {
+	return Gen[string]{
+		Generate: func(r *rand.Rand, size int) string {
+			b := make([]byte, r.IntN(size+1))
+			for i := range b {
+				b[i] = byte(' ' + r.IntN('~'-' '+1))
+			}
+
+			return string(b)
+		},
+		Shrink: func(v string) []string {
+			result := make([]string, 0)
+			for _, b := range shrinkSlice([]byte(v), nil) {
+				result = append(result, string(b))
+			}
+
+			return result
+		},
+	}
+}
+
+// SliceOf generates slices of at most size elements drawn from elem. Failing
+// slices are shrunk by dropping elements, then by shrinking them one by one.
+func SliceOf[T any](elem Gen[T]) Gen[[]T] {
+	return Gen[[]T]{
+		Generate: func(r *rand.Rand, size int) []T {
+			result := make([]T, r.IntN(size+1))
+			for i := range result {
+				result[i] = elem.Generate(r, size)
+			}
+
+			return result
+		},
+		Shrink: func(v []T) [][]T {
+			return shrinkSlice(v, elem.shrink)
+		},
+	}
+}
+
+func shrinkSlice[T any](v []T, shrinkElem func(T) []T) [][]T {
+	if len(v) == 0 {
+		return nil
+	}
+
+	result := [][]T{{}}
+	if len(v) > 1 {
+		half := len(v) / 2
+		result = append(result, clip(v[:half]), clip(v[half:]))
+	}
+
+	for i := range v {
+		without := append(clip(v[:i]), v[i+1:]...)
+		result = append(result, without)
+	}
+
+	if shrinkElem == nil {
+		return result
+	}
+
+	for i := range v {
+		for _, c := range shrinkElem(v[i]) {
+			shrunk := append(clip(v[:0]), v...)
+			shrunk[i] = c
+			result = append(result, shrunk)
+		}
+	}
+
+	return result
+}
+
+// clip returns a copy of v, so appending to it never writes into v.
+func clip[T any](v []T) []T {
+	return append(make([]T, 0, len(v)), v...)
+}
+
+// MapOf generates maps of at most size entries. Failing maps are shrunk by
+// dropping entries, then by shrinking their values.
+func MapOf[K comparable, V any](key Gen[K], val Gen[V]) Gen[map[K]V] {
+	return Gen[map[K]V]{
+		Generate: func(r *rand.Rand, size int) map[K]V {
+			n := r.IntN(size + 1)
+			result := make(map[K]V, n)
+
+			for i := 0; i < n; i++ {
+				result[key.Generate(r, size)] = val.Generate(r, size)
+			}
+
+			return result
+		},
+		Shrink: func(v map[K]V) []map[K]V {
+			if len(v) == 0 {
+				return nil
+			}
+
+			result := []map[K]V{{}}
+			for k := range v {
+				without := copyMap(v)
+				delete(without, k)
+				result = append(result, without)
+			}
+
+			for k, x := range v {
+				for _, c := range val.shrink(x) {
+					shrunk := copyMap(v)
+					shrunk[k] = c
+					result = append(result, shrunk)
+				}
+			}
+
+			return result
+		},
+	}
+}
+
+func copyMap[K comparable, V any](m map[K]V) map[K]V {
+	result := make(map[K]V, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+
+	return result
+}
+
+// Map generates fn applied to values of g. The results cannot be shrunk,
+// since fn cannot be inverted; shrink the input of fn in the property
+// instead when that matters.
+func Map[T, R any](g Gen[T], fn func(T) R) Gen[R] {
+	return Gen[R]{
+		Generate: func(r *rand.Rand, size int) R { return fn(g.Generate(r, size)) },
+	}
+}
+
+// PairOf generates pairs whose components are drawn from a and b and shrunk
+// independently.
+func PairOf[A, B any](a Gen[A], b Gen[B]) Gen[tuple.Pair[A, B]] {
+	return Gen[tuple.Pair[A, B]]{
+		Generate: func(r *rand.Rand, size int) tuple.Pair[A, B] {
+			return tuple.NewPair(a.Generate(r, size), b.Generate(r, size))
+		},
+		Shrink: func(v tuple.Pair[A, B]) []tuple.Pair[A, B] {
+			result := make([]tuple.Pair[A, B], 0)
+			for _, c := range a.shrink(v.First) {
+				result = append(result, tuple.NewPair(c, v.Second))
+			}
+
+			for _, c := range b.shrink(v.Second) {
+				result = append(result, tuple.NewPair(v.First, c))
+			}
+
+			return result
+		},
+	}
+}
+
+// TripleOf generates triples whose components are drawn from g and shrunk
+// independently.
+func TripleOf[T any](g Gen[T]) Gen[tuple.Triple[T, T, T]] {
+	return Gen[tuple.Triple[T, T, T]]{
+		Generate: func(r *rand.Rand, size int) tuple.Triple[T, T, T] {
+			return tuple.NewTriple(g.Generate(r, size), g.Generate(r, size), g.Generate(r, size))
+		},
+		Shrink: func(v tuple.Triple[T, T, T]) []tuple.Triple[T, T, T] {
+			result := make([]tuple.Triple[T, T, T], 0)
+			for _, c := range g.shrink(v.First) {
+				result = append(result, tuple.NewTriple(c, v.Second, v.Third))
+			}
+
+			for _, c := range g.shrink(v.Second) {
+				result = append(result, tuple.NewTriple(v.First, c, v.Third))
+			}
+
+			for _, c := range g.shrink(v.Third) {
+				result = append(result, tuple.NewTriple(v.First, v.Second, c))
+			}
+
+			return result
+		},
+	}
+}
@@ -0,0 +1,247 @@
+package gentest
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// recorder captures the failures reported by Check instead of failing the
+// test that runs it.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+type point struct {
+	X, Y  int
+	Label string
+	Tags  []string
+	Next  *point
+	note  string
+}
+
+// sum is a MergingHandler that adds its values.
+type sum struct{ N int }
+
+func (s sum) Merge(next sum) sum { return sum{s.N + next.N} }
+
+// last is a MergingHandler that keeps the most recent value; it is
+// associative but has no identity.
+type last struct{ N int }
+
+func (l last) Merge(next last) last { return next }
+
+// diff is a MergingHandler that is not associative.
+type diff struct{ N int }
+
+func (d diff) Merge(next diff) diff { return diff{d.N - next.N} }
+
+func TestGenerators(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 1))
+
+	for i := 0; i < 100; i++ {
+		if v := Int().Generate(r, 5); v < -5 || v > 5 {
+			t.Errorf("Expected Int to stay within the size, but got %d", v)
+		}
+
+		if v := IntRange(3, 4).Generate(r, 100); v < 3 || v > 4 {
+			t.Errorf("Expected IntRange(3, 4) to stay within its bounds, but got %d", v)
+		}
+
+		if v := String().Generate(r, 8); len(v) > 8 {
+			t.Errorf("Expected String to have at most 8 characters, but got %q", v)
+		}
+
+		if v := SliceOf(Int()).Generate(r, 4); len(v) > 4 {
+			t.Errorf("Expected SliceOf to have at most 4 elements, but got %v", v)
+		}
+
+		if v := MapOf(Int(), Bool()).Generate(r, 4); len(v) > 4 {
+			t.Errorf("Expected MapOf to have at most 4 entries, but got %v", v)
+		}
+
+		if v := OneOf("a", "b").Generate(r, 0); v != "a" && v != "b" {
+			t.Errorf("Expected OneOf to pick a given value, but got %q", v)
+		}
+	}
+
+	if v := Map(Int(), func(n int) string { return fmt.Sprint(n) }).Generate(r, 0); v != "0" {
+		t.Errorf("Expected %q, but got %q", "0", v)
+	}
+}
+
+func TestArbitrary(t *testing.T) {
+	r := rand.New(rand.NewPCG(2, 2))
+	g := Arbitrary[point]()
+
+	nonZero := 0
+	for i := 0; i < 50; i++ {
+		p := g.Generate(r, 10)
+		if p.note != "" {
+			t.Fatalf("Expected unexported fields to stay zero, but got %q", p.note)
+		}
+
+		if !reflect.DeepEqual(p, point{}) {
+			nonZero++
+		}
+	}
+
+	if nonZero == 0 {
+		t.Errorf("Expected Arbitrary to fill exported fields")
+	}
+
+	m := Arbitrary[map[string][]int]().Generate(r, 10)
+	if m == nil {
+		t.Errorf("Expected a non-nil map")
+	}
+
+	for _, v := range Arbitrary[[3]int8]().Generate(r, 1000) {
+		if v < -100 || v > 100 {
+			t.Errorf("Expected nested sizes to be halved, but got %d", v)
+		}
+	}
+}
+
+func TestShrink(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(tb testing.TB) bool
+		want string
+	}{
+		{
+			name: "int",
+			run: func(tb testing.TB) bool {
+				return Check(tb, Int(), func(n int) bool { return n < 10 })
+			},
+			want: "counterexample: 10\n",
+		},
+		{
+			name: "slice",
+			run: func(tb testing.TB) bool {
+				return Check(tb, SliceOf(Int()), func(items []int) bool {
+					return !slices.Contains(items, 7)
+				}, WithRuns(1000))
+			},
+			want: "counterexample: []int{7}\n",
+		},
+		{
+			name: "string",
+			run: func(tb testing.TB) bool {
+				return Check(tb, String(), func(s string) bool { return len(s) < 3 })
+			},
+			want: "counterexample: \"",
+		},
+		{
+			name: "map",
+			run: func(tb testing.TB) bool {
+				return Check(tb, MapOf(String(), Int()), func(m map[string]int) bool { return len(m) < 2 })
+			},
+			want: "counterexample: map[string]int{",
+		},
+		{
+			name: "struct",
+			run: func(tb testing.TB) bool {
+				return Check(tb, Arbitrary[point](), func(p point) bool { return p.X <= p.Y })
+			},
+			want: `Label:"", Tags:[]string(nil), Next:(*gentest.point)(nil), note:""}` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &recorder{TB: t}
+			if tt.run(rec) {
+				t.Fatalf("Expected the property to fail")
+			}
+
+			if len(rec.errors) != 1 || !strings.Contains(rec.errors[0], tt.want) {
+				t.Errorf("Expected a report containing %q, but got %q", tt.want, rec.errors)
+			}
+
+			if !strings.Contains(rec.errors[0], "seed ") {
+				t.Errorf("Expected the report to include the seed, but got %q", rec.errors[0])
+			}
+		})
+	}
+}
+
+func TestCheckSeed(t *testing.T) {
+	collect := func(seed uint64) []int {
+		var result []int
+		Check(t, Int(), func(n int) bool {
+			result = append(result, n)
+			return true
+		}, WithSeed(seed), WithRuns(20))
+
+		return result
+	}
+
+	if a, b := collect(42), collect(42); !reflect.DeepEqual(a, b) {
+		t.Errorf("Expected the same seed to replay the same values, but got %v and %v", a, b)
+	}
+
+	if a, b := collect(1), collect(2); reflect.DeepEqual(a, b) {
+		t.Errorf("Expected different seeds to draw different values")
+	}
+}
+
+func TestLaws(t *testing.T) {
+	if !MapIdentity(t, Arbitrary[point]()) {
+		t.Errorf("Expected MapIdentity to hold")
+	}
+
+	if !MapComposition(t, Int(), func(n int) string { return fmt.Sprint(n) }, func(s string) int { return len(s) }) {
+		t.Errorf("Expected MapComposition to hold")
+	}
+
+	if !ReduceAssociativity(t, Int(), 0, func(a, b int) int { return a + b }) {
+		t.Errorf("Expected ReduceAssociativity to hold for addition")
+	}
+
+	if !MergeAssociativity(t, Arbitrary[sum]()) || !MergeIdentity(t, Arbitrary[sum](), sum{}) {
+		t.Errorf("Expected the merge laws to hold for sum")
+	}
+
+	if !MergeAssociativity(t, Arbitrary[last]()) {
+		t.Errorf("Expected MergeAssociativity to hold for last")
+	}
+}
+
+func TestLawViolations(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(tb testing.TB) bool
+	}{
+		{"reduce with subtraction", func(tb testing.TB) bool {
+			return ReduceAssociativity(tb, Int(), 0, func(a, b int) int { return a - b })
+		}},
+		{"reduce with a non-neutral identity", func(tb testing.TB) bool {
+			return ReduceAssociativity(tb, Int(), 1, func(a, b int) int { return a + b })
+		}},
+		{"merge associativity", func(tb testing.TB) bool {
+			return MergeAssociativity(tb, Arbitrary[diff]())
+		}},
+		{"merge identity", func(tb testing.TB) bool {
+			return MergeIdentity(tb, Arbitrary[last](), last{})
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &recorder{TB: t}
+			if tt.run(rec) || len(rec.errors) == 0 {
+				t.Errorf("Expected the law to be reported as violated")
+			}
+		})
+	}
+}
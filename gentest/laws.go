@@ -0,0 +1,86 @@
+package gentest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cirius-go/generic/slice"
+	"github.com/cirius-go/generic/tuple"
+	"github.com/cirius-go/generic/types"
+)
+
+// The law checkers below run Check against a law of this library. Values
+// are compared with reflect.DeepEqual, except that nil and empty result
+// slices are considered equal.
+
+// MapIdentity checks that slice.Map with the identity function returns its
+// input, for slices of values drawn from elem.
+func MapIdentity[T any](t testing.TB, elem Gen[T], opts ...Option) bool {
+	t.Helper()
+
+	return Check(t, SliceOf(elem), func(items []T) bool {
+		return sameSlice(slice.Map(func(v T) T { return v }, items...), items)
+	}, opts...)
+}
+
+// MapComposition checks that mapping f then g with slice.Map equals mapping
+// their composition once, for slices of values drawn from elem.
+func MapComposition[T, U, V any](t testing.TB, elem Gen[T], f func(T) U, g func(U) V, opts ...Option) bool {
+	t.Helper()
+
+	return Check(t, SliceOf(elem), func(items []T) bool {
+		composed := slice.Map(func(v T) V { return g(f(v)) }, items...)
+		chained := slice.Map(g, slice.Map(f, items...)...)
+
+		return sameSlice(composed, chained)
+	}, opts...)
+}
+
+// ReduceAssociativity checks that op is associative on values drawn from
+// elem, and that slice.Reduce from identity over a concatenation equals op
+// applied to the reductions of both halves. The latter only holds when
+// identity is neutral for op.
+func ReduceAssociativity[T any](t testing.TB, elem Gen[T], identity T, op func(T, T) T, opts ...Option) bool {
+	t.Helper()
+
+	associative := Check(t, TripleOf(elem), func(v tuple.Triple[T, T, T]) bool {
+		return reflect.DeepEqual(op(op(v.First, v.Second), v.Third), op(v.First, op(v.Second, v.Third)))
+	}, opts...)
+
+	halves := SliceOf(elem)
+	split := Check(t, PairOf(halves, halves), func(v tuple.Pair[[]T, []T]) bool {
+		whole := slice.Reduce(identity, op, slice.Concat(v.First, v.Second)...)
+		parts := op(slice.Reduce(identity, op, v.First...), slice.Reduce(identity, op, v.Second...))
+
+		return reflect.DeepEqual(whole, parts)
+	}, opts...)
+
+	return associative && split
+}
+
+// MergeAssociativity checks that a.Merge(b).Merge(c) equals
+// a.Merge(b.Merge(c)) for handlers drawn from g.
+func MergeAssociativity[M types.MergingHandler[M]](t testing.TB, g Gen[M], opts ...Option) bool {
+	t.Helper()
+
+	return Check(t, TripleOf(g), func(v tuple.Triple[M, M, M]) bool {
+		return reflect.DeepEqual(v.First.Merge(v.Second).Merge(v.Third), v.First.Merge(v.Second.Merge(v.Third)))
+	}, opts...)
+}
+
+// MergeIdentity checks that identity is neutral for Merge on both sides, and
+// that slice.ReduceMergeFn from identity returns a single handler unchanged,
+// for handlers drawn from g.
+func MergeIdentity[M types.MergingHandler[M]](t testing.TB, g Gen[M], identity M, opts ...Option) bool {
+	t.Helper()
+
+	return Check(t, g, func(v M) bool {
+		return reflect.DeepEqual(identity.Merge(v), v) &&
+			reflect.DeepEqual(v.Merge(identity), v) &&
+			reflect.DeepEqual(slice.ReduceMergeFn(identity, v), v)
+	}, opts...)
+}
+
+func sameSlice[T any](a, b []T) bool {
+	return len(a) == 0 && len(b) == 0 || reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,189 @@
+package heap
+
+import "cmp"
+
+// Heap is a binary heap ordered by a less function: the element for which
+// less reports true against every other element is at the top.
+//
+// Push and Pop run in O(log n), Peek in O(1). A Heap is not safe for
+// concurrent use.
+type Heap[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+// New returns a heap ordered by less holding the given items. The items are
+// copied and heapified in O(n).
+func New[T any](less func(a, b T) bool, items ...T) *Heap[T] {
+	h := &Heap[T]{
+		items: append(make([]T, 0, len(items)), items...),
+		less:  less,
+	}
+
+	for i := len(h.items)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+
+	return h
+}
+
+// NewMin returns a heap whose top is the smallest element.
+func NewMin[T cmp.Ordered](items ...T) *Heap[T] {
+	return New(cmp.Less[T], items...)
+}
+
+// NewMax returns a heap whose top is the largest element.
+func NewMax[T cmp.Ordered](items ...T) *Heap[T] {
+	return New(func(a, b T) bool { return cmp.Less(b, a) }, items...)
+}
+
+// Len returns the number of elements.
+func (h *Heap[T]) Len() int {
+	return len(h.items)
+}
+
+// Push adds v to the heap.
+func (h *Heap[T]) Push(v T) {
+	h.items = append(h.items, v)
+	h.up(len(h.items) - 1)
+}
+
+// Peek returns the top element without removing it.
+func (h *Heap[T]) Peek() (T, bool) {
+	if len(h.items) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return h.items[0], true
+}
+
+// Pop removes and returns the top element.
+func (h *Heap[T]) Pop() (T, bool) {
+	return h.Remove(0)
+}
+
+// Remove removes and returns the element at index i of the underlying
+// array, as exposed by Items.
+func (h *Heap[T]) Remove(i int) (T, bool) {
+	if i < 0 || i >= len(h.items) {
+		var zero T
+		return zero, false
+	}
+
+	last := len(h.items) - 1
+	v := h.items[i]
+
+	h.swap(i, last)
+	var zero T
+	h.items[last] = zero
+	h.items = h.items[:last]
+
+	if i < last {
+		h.Fix(i)
+	}
+
+	return v, true
+}
+
+// Fix restores the heap order after the element at index i was changed in
+// place, for example through a pointer stored in the heap.
+func (h *Heap[T]) Fix(i int) {
+	if i < 0 || i >= len(h.items) {
+		return
+	}
+
+	if !h.down(i) {
+		h.up(i)
+	}
+}
+
+// Items returns the underlying array in heap order. It is only meant to
+// locate indices for Fix and Remove and must not be modified otherwise.
+func (h *Heap[T]) Items() []T {
+	return h.items
+}
+
+// Drain pops every element and returns them in order, leaving the heap
+// empty.
+func (h *Heap[T]) Drain() []T {
+	result := make([]T, 0, len(h.items))
+	for len(h.items) > 0 {
+		v, _ := h.Pop()
+		result = append(result, v)
+	}
+
+	return result
+}
+
+func (h *Heap[T]) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(h.items[i], h.items[parent]) {
+			return
+		}
+
+		h.swap(i, parent)
+		i = parent
+	}
+}
+
+// down sifts the element at i down and reports whether it moved.
+func (h *Heap[T]) down(i int) bool {
+	start := i
+	for {
+		best := i
+		left, right := 2*i+1, 2*i+2
+
+		if left < len(h.items) && h.less(h.items[left], h.items[best]) {
+			best = left
+		}
+
+		if right < len(h.items) && h.less(h.items[right], h.items[best]) {
+			best = right
+		}
+
+		if best == i {
+			return i > start
+		}
+
+		h.swap(i, best)
+		i = best
+	}
+}
+
+// TopK returns the k elements of items that come first according to less,
+// in order, without modifying items. With cmp.Less these are the k smallest
+// elements.
+//
+// It keeps a heap of at most k elements, so it runs in O(n log k).
+func TopK[T any](k int, less func(a, b T) bool, items ...T) []T {
+	if k > len(items) {
+		k = len(items)
+	}
+
+	if k <= 0 {
+		return []T{}
+	}
+
+	// The heap is reversed so its top is the worst of the k best elements
+	// seen so far, which is the one to replace.
+	worst := New(func(a, b T) bool { return less(b, a) }, items[:k]...)
+	for _, v := range items[k:] {
+		if top, _ := worst.Peek(); less(v, top) {
+			worst.items[0] = v
+			worst.down(0)
+		}
+	}
+
+	result := worst.Drain()
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+
+	return result
+}
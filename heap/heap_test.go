@@ -0,0 +1,143 @@
+package heap
+
+import (
+	"cmp"
+	"reflect"
+	"testing"
+)
+
+func TestMinMax(t *testing.T) {
+	min := NewMin(5, 3, 8, 1, 9, 2)
+	min.Push(0)
+	min.Push(7)
+
+	if v, ok := min.Peek(); !ok || v != 0 {
+		t.Errorf("Peek() = %v, %v, want 0, true", v, ok)
+	}
+
+	if got := min.Drain(); !reflect.DeepEqual(got, []int{0, 1, 2, 3, 5, 7, 8, 9}) {
+		t.Errorf("Drain() = %v", got)
+	}
+
+	if _, ok := min.Pop(); ok {
+		t.Errorf("Expected Pop on an empty heap to report false")
+	}
+
+	max := NewMax("b", "d", "a", "c")
+	if got := max.Drain(); !reflect.DeepEqual(got, []string{"d", "c", "b", "a"}) {
+		t.Errorf("Drain() = %v", got)
+	}
+}
+
+func TestNewCopiesItems(t *testing.T) {
+	items := []int{3, 1, 2}
+	h := NewMin(items...)
+	h.Pop()
+
+	if !reflect.DeepEqual(items, []int{3, 1, 2}) {
+		t.Errorf("Expected New to leave its input untouched, but got %v", items)
+	}
+}
+
+type job struct {
+	Name     string
+	Priority int
+}
+
+func TestFixAndRemove(t *testing.T) {
+	h := New(func(a, b *job) bool { return a.Priority < b.Priority },
+		&job{"a", 5}, &job{"b", 3}, &job{"c", 8}, &job{"d", 1})
+
+	// Raise the priority of c in place and fix its position.
+	for i, j := range h.Items() {
+		if j.Name == "c" {
+			j.Priority = 0
+			h.Fix(i)
+		}
+	}
+
+	if top, _ := h.Peek(); top.Name != "c" {
+		t.Errorf("Peek() = %v, want c", top.Name)
+	}
+
+	for i, j := range h.Items() {
+		if j.Name == "b" {
+			h.Remove(i)
+			break
+		}
+	}
+
+	names := make([]string, 0)
+	for _, j := range h.Drain() {
+		names = append(names, j.Name)
+	}
+
+	if !reflect.DeepEqual(names, []string{"c", "d", "a"}) {
+		t.Errorf("Drain() = %v, want %v", names, []string{"c", "d", "a"})
+	}
+
+	if _, ok := h.Remove(3); ok {
+		t.Errorf("Expected Remove with an invalid index to report false")
+	}
+}
+
+func TestIndexed(t *testing.T) {
+	h := NewIndexed[string](cmp.Less[int])
+
+	h.Push("a", 10)
+	h.Push("b", 20)
+	h.Push("c", 30)
+	h.Push("d", 5)
+
+	// Decrease-key, as Dijkstra does when a shorter path is found.
+	h.Update("c", 1)
+	// Increase-key.
+	h.Push("d", 40)
+
+	if !h.Contains("b") || h.Contains("z") {
+		t.Errorf("Unexpected Contains result")
+	}
+
+	if v, ok := h.Get("d"); !ok || v != 40 {
+		t.Errorf("Get(d) = %v, %v, want 40, true", v, ok)
+	}
+
+	if v, ok := h.Remove("b"); !ok || v != 20 {
+		t.Errorf("Remove(b) = %v, %v, want 20, true", v, ok)
+	}
+
+	keys := make([]string, 0)
+	for h.Len() > 0 {
+		k, _, _ := h.Pop()
+		keys = append(keys, k)
+	}
+
+	if !reflect.DeepEqual(keys, []string{"c", "a", "d"}) {
+		t.Errorf("Pop order = %v, want %v", keys, []string{"c", "a", "d"})
+	}
+
+	if h.Update("a", 1) {
+		t.Errorf("Expected Update on a missing key to report false")
+	}
+}
+
+func TestTopK(t *testing.T) {
+	items := []int{9, 4, 7, 1, 8, 2, 6}
+
+	if got := TopK(3, cmp.Less[int], items...); !reflect.DeepEqual(got, []int{1, 2, 4}) {
+		t.Errorf("TopK() = %v", got)
+	}
+
+	largest := TopK(2, func(a, b int) bool { return a > b }, items...)
+	if !reflect.DeepEqual(largest, []int{9, 8}) {
+		t.Errorf("TopK() = %v", largest)
+	}
+
+	if got := TopK(10, cmp.Less[int], 2, 1); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("TopK() = %v", got)
+	}
+
+	if got := TopK(0, cmp.Less[int], items...); len(got) != 0 {
+		t.Errorf("TopK() = %v, want empty", got)
+	}
+}
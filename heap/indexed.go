@@ -0,0 +1,169 @@
+package heap
+
+type indexedItem[K comparable, T any] struct {
+	key K
+	val T
+}
+
+// Indexed is a binary heap whose elements are addressed by a unique key, so
+// their priority can be changed after insertion (decrease-key) without
+// searching the heap. It is the usual building block of Dijkstra-style
+// schedulers.
+type Indexed[K comparable, T any] struct {
+	items []indexedItem[K, T]
+	pos   map[K]int
+	less  func(a, b T) bool
+}
+
+// NewIndexed returns an empty indexed heap ordered by less.
+func NewIndexed[K comparable, T any](less func(a, b T) bool) *Indexed[K, T] {
+	return &Indexed[K, T]{
+		pos:  make(map[K]int),
+		less: less,
+	}
+}
+
+// Len returns the number of elements.
+func (h *Indexed[K, T]) Len() int {
+	return len(h.items)
+}
+
+// Contains reports whether key is in the heap.
+func (h *Indexed[K, T]) Contains(key K) bool {
+	_, ok := h.pos[key]
+
+	return ok
+}
+
+// Get returns the value stored for key.
+func (h *Indexed[K, T]) Get(key K) (T, bool) {
+	i, ok := h.pos[key]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+
+	return h.items[i].val, true
+}
+
+// Push inserts key with val, or updates the value of key if it is already
+// in the heap.
+func (h *Indexed[K, T]) Push(key K, val T) {
+	if h.Update(key, val) {
+		return
+	}
+
+	h.items = append(h.items, indexedItem[K, T]{key: key, val: val})
+	h.pos[key] = len(h.items) - 1
+	h.up(len(h.items) - 1)
+}
+
+// Update changes the value of key and restores the heap order in
+// O(log n). It works for both decreasing and increasing priorities and
+// reports whether key was present.
+func (h *Indexed[K, T]) Update(key K, val T) bool {
+	i, ok := h.pos[key]
+	if !ok {
+		return false
+	}
+
+	h.items[i].val = val
+	if !h.down(i) {
+		h.up(i)
+	}
+
+	return true
+}
+
+// Peek returns the top key and value without removing them.
+func (h *Indexed[K, T]) Peek() (K, T, bool) {
+	if len(h.items) == 0 {
+		var (
+			key K
+			val T
+		)
+		return key, val, false
+	}
+
+	return h.items[0].key, h.items[0].val, true
+}
+
+// Pop removes and returns the top key and value.
+func (h *Indexed[K, T]) Pop() (K, T, bool) {
+	if len(h.items) == 0 {
+		var (
+			key K
+			val T
+		)
+		return key, val, false
+	}
+
+	top := h.items[0]
+	h.Remove(top.key)
+
+	return top.key, top.val, true
+}
+
+// Remove deletes key from the heap and returns its value.
+func (h *Indexed[K, T]) Remove(key K) (T, bool) {
+	i, ok := h.pos[key]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+
+	val := h.items[i].val
+	last := len(h.items) - 1
+
+	h.swap(i, last)
+	h.items[last] = indexedItem[K, T]{}
+	h.items = h.items[:last]
+	delete(h.pos, key)
+
+	if i < last && !h.down(i) {
+		h.up(i)
+	}
+
+	return val, true
+}
+
+func (h *Indexed[K, T]) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.pos[h.items[i].key] = i
+	h.pos[h.items[j].key] = j
+}
+
+func (h *Indexed[K, T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(h.items[i].val, h.items[parent].val) {
+			return
+		}
+
+		h.swap(i, parent)
+		i = parent
+	}
+}
+
+func (h *Indexed[K, T]) down(i int) bool {
+	start := i
+	for {
+		best := i
+		left, right := 2*i+1, 2*i+2
+
+		if left < len(h.items) && h.less(h.items[left].val, h.items[best].val) {
+			best = left
+		}
+
+		if right < len(h.items) && h.less(h.items[right].val, h.items[best].val) {
+			best = right
+		}
+
+		if best == i {
+			return i > start
+		}
+
+		h.swap(i, best)
+		i = best
+	}
+}
@@ -0,0 +1,164 @@
+package benchtest
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Case exercises one exported function or method. F is the signature shared
+// by the cases of a package, which receives the input prepared by a Bind.
+type Case[F any] struct {
+	// Name is the function name, or Type.Method for a method.
+	Name string
+	Fn   F
+	// Allocs is the allocation budget per run, enforced by Allocs. A
+	// negative budget skips the check, for functions whose allocations
+	// depend on randomness. Functions that grow maps allocate a varying
+	// amount depending on the hash seed, so their budget must leave
+	// headroom above the observed count.
+	Allocs float64
+}
+
+// Bind prepares an input of size n and returns a function that runs a case
+// on it. Every run of a case must see the same input.
+type Bind[F any] func(n int) func(fn F)
+
+// Benchmark runs every case at every size.
+func Benchmark[F any](b *testing.B, cases []Case[F], sizes []int, bind Bind[F]) {
+	for _, c := range cases {
+		for _, n := range sizes {
+			run := bind(n)
+
+			b.Run(c.Name+"/n="+strconv.Itoa(n), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					run(c.Fn)
+				}
+			})
+		}
+	}
+}
+
+// allocsRounds is how many averages Allocs takes per case. A regression
+// raises all of them, while the runtime occasionally allocates on its own
+// during a single round.
+const allocsRounds = 5
+
+// Allocs fails when a case allocates more than its budget at size n, so that
+// allocation regressions are caught by go test rather than by a profiler.
+// The lowest of several averages is compared with the budget, so that
+// transient runtime allocations do not make it flaky. It is skipped under
+// the race detector.
+func Allocs[F any](t *testing.T, cases []Case[F], n int, bind Bind[F]) {
+	t.Helper()
+
+	if RaceEnabled {
+		t.Skip("allocation counts differ under the race detector")
+	}
+
+	run := bind(n)
+
+	for _, c := range cases {
+		if c.Allocs < 0 {
+			continue
+		}
+
+		t.Run(c.Name, func(t *testing.T) {
+			got := testing.AllocsPerRun(20, func() { run(c.Fn) })
+			for i := 1; i < allocsRounds && got > c.Allocs; i++ {
+				got = min(got, testing.AllocsPerRun(20, func() { run(c.Fn) }))
+			}
+
+			if got > c.Allocs {
+				t.Errorf("%s allocates %v times per run at n=%d, budget is %v", c.Name, got, n, c.Allocs)
+			}
+		})
+	}
+}
+
+// Coverage fails when an exported function or method of the package in dir
+// has no case, or when two cases share a name.
+func Coverage[F any](t *testing.T, cases []Case[F], dir string) {
+	t.Helper()
+
+	names := make(map[string]bool)
+	for _, c := range cases {
+		if names[c.Name] {
+			t.Errorf("duplicate benchmark case %s", c.Name)
+		}
+		names[c.Name] = true
+	}
+
+	exported, err := Exported(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range exported {
+		if !names[name] {
+			t.Errorf("exported function %s has no benchmark case", name)
+		}
+	}
+}
+
+// Exported returns the exported functions of the non-test files in dir, and
+// the exported methods of their exported types as Type.Method.
+func Exported(dir string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0)
+	fset := token.NewFileSet()
+
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, name, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || !fn.Name.IsExported() {
+				continue
+			}
+
+			if fn.Recv == nil {
+				result = append(result, fn.Name.Name)
+				continue
+			}
+
+			if recv := receiverName(fn.Recv.List[0].Type); ast.IsExported(recv) {
+				result = append(result, recv+"."+fn.Name.Name)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// receiverName returns the type name of a receiver such as *OrderedMap[K, V].
+func receiverName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		return receiverName(e.X)
+	case *ast.IndexExpr:
+		return receiverName(e.X)
+	case *ast.IndexListExpr:
+		return receiverName(e.X)
+	case *ast.Ident:
+		return e.Name
+	}
+
+	return ""
+}
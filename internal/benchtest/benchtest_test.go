@@ -0,0 +1,44 @@
+package benchtest
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestExported(t *testing.T) {
+	dir := t.TempDir()
+
+	src := `package p
+
+type Map[K comparable, V any] map[K]V
+
+type pair[A, B any] struct{}
+
+type List []int
+
+func New() {}
+func helper() {}
+func (m *Map[K, V]) Get() {}
+func (m Map[K, V]) len() {}
+func (p pair[A, B]) First() {}
+func (l List) Len() {}
+`
+	if err := os.WriteFile(filepath.Join(dir, "p.go"), []byte(src), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "p_test.go"), []byte("package p\n\nfunc TestX() {}\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Exported(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"New", "Map.Get", "List.Len"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, but got %v", want, got)
+	}
+}
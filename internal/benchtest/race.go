@@ -0,0 +1,7 @@
+//go:build race
+
+package benchtest
+
+// RaceEnabled reports whether the race detector is on. It changes inlining
+// and escape analysis, so allocation budgets only hold without it.
+const RaceEnabled = true
@@ -0,0 +1,276 @@
+package iterator
+
+import "iter"
+
+// FromSlice returns a sequence that yields the given items in order.
+//
+// The items are not copied, so mutating the backing array while the
+// sequence is being consumed is visible to the consumer.
+func FromSlice[T any](items ...T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range items {
+			if !yield(items[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Indexed returns a sequence that yields the index and value of each item.
+func Indexed[T any](items ...T) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := range items {
+			if !yield(i, items[i]) {
+				return
+			}
+		}
+	}
+}
+
+// FromMap returns a sequence that yields every key/value pair of m.
+//
+// Like ranging over a map, the order of the pairs is unspecified.
+func FromMap[K comparable, V any](m map[K]V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns a sequence that yields every key of m in unspecified order.
+func Keys[K comparable, V any](m map[K]V) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Vals returns a sequence that yields every value of m in unspecified order.
+func Vals[K comparable, V any](m map[K]V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// FromChan returns a sequence that yields values received from ch until it is
+// closed or the consumer stops.
+//
+// Stopping early leaves the remaining values in the channel; the producer is
+// responsible for not blocking forever on a channel nobody reads anymore.
+func FromChan[T any](ch <-chan T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Map returns a sequence that yields callback(v) for each v in seq.
+//
+// The callback is only invoked when the consumer pulls the next value.
+func Map[T, R any](callback func(T) R, seq iter.Seq[T]) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		for v := range seq {
+			if !yield(callback(v)) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns a sequence that yields only the values of seq that satisfy
+// the predicate. A nil predicate keeps every value, matching slice.Filter.
+func Filter[T any](predicate func(T) bool, seq iter.Seq[T]) iter.Seq[T] {
+	if predicate == nil {
+		return seq
+	}
+
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if !predicate(v) {
+				continue
+			}
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// MapSkip returns a sequence that yields the mapped values of seq, skipping
+// every value for which the callback reports true as its second result.
+//
+// It is the lazy counterpart of slice.MapSkip.
+func MapSkip[T, R any](callback func(T) (R, bool), seq iter.Seq[T]) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		for v := range seq {
+			r, skip := callback(v)
+			if skip {
+				continue
+			}
+
+			if !yield(r) {
+				return
+			}
+		}
+	}
+}
+
+// Take returns a sequence that yields at most the first n values of seq.
+//
+// The source is not pulled past the n-th value.
+func Take[T any](n int, seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+
+		taken := 0
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+
+			taken++
+			if taken >= n {
+				return
+			}
+		}
+	}
+}
+
+// Skip returns a sequence that discards the first n values of seq and yields
+// the rest.
+func Skip[T any](n int, seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		skipped := 0
+		for v := range seq {
+			if skipped < n {
+				skipped++
+				continue
+			}
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhile returns a sequence that yields values of seq as long as they
+// satisfy the predicate, and stops at the first value that does not.
+func TakeWhile[T any](predicate func(T) bool, seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if !predicate(v) || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Chunk returns a sequence of consecutive chunks of seq, each of size to
+// except possibly the last one.
+//
+// It is the lazy counterpart of slice.Divide. Every chunk is a freshly
+// allocated slice, so consumers may retain it. Chunk panics if to is less
+// than 1.
+func Chunk[T any](to int, seq iter.Seq[T]) iter.Seq[[]T] {
+	if to < 1 {
+		panic("iterator: chunk size should be greater than 0")
+	}
+
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, to)
+		for v := range seq {
+			chunk = append(chunk, v)
+			if len(chunk) < to {
+				continue
+			}
+
+			if !yield(chunk) {
+				return
+			}
+
+			chunk = make([]T, 0, to)
+		}
+
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Filter2 returns a sequence that yields only the pairs of seq that satisfy
+// the predicate.
+func Filter2[K, V any](predicate func(K, V) bool, seq iter.Seq2[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range seq {
+			if !predicate(k, v) {
+				continue
+			}
+
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Map2 returns a sequence that yields callback(k, v) for each pair of seq.
+func Map2[K, V, R any](callback func(K, V) R, seq iter.Seq2[K, V]) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		for k, v := range seq {
+			if !yield(callback(k, v)) {
+				return
+			}
+		}
+	}
+}
+
+// Collect drains seq into a new slice.
+//
+// The result is never nil, so an empty sequence collects into an empty slice.
+func Collect[T any](seq iter.Seq[T]) []T {
+	result := make([]T, 0)
+
+	for v := range seq {
+		result = append(result, v)
+	}
+
+	return result
+}
+
+// CollectMap drains seq into a new map. Later pairs overwrite earlier pairs
+// that share the same key.
+func CollectMap[K comparable, V any](seq iter.Seq2[K, V]) map[K]V {
+	result := make(map[K]V)
+
+	for k, v := range seq {
+		result[k] = v
+	}
+
+	return result
+}
+
+// Reduce folds every value of seq into an accumulator, from first to last.
+func Reduce[T, R any](initialValue R, callback func(R, T) R, seq iter.Seq[T]) R {
+	for v := range seq {
+		initialValue = callback(initialValue, v)
+	}
+
+	return initialValue
+}
@@ -0,0 +1,176 @@
+package iterator
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/cirius-go/generic/slice"
+)
+
+func TestMapFilterCollect(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	isEven := func(i int) bool { return i%2 == 0 }
+	double := func(i int) int { return i * 2 }
+
+	expected := slice.Map(double, slice.Filter(isEven, items...)...)
+	result := Collect(Map(double, Filter(isEven, FromSlice(items...))))
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case: empty input collects into an empty, non-nil slice
+	empty := Collect(Filter(isEven, FromSlice[int]()))
+	if empty == nil || len(empty) != 0 {
+		t.Errorf("Expected an empty slice, but got %#v", empty)
+	}
+}
+
+func TestMapIsLazy(t *testing.T) {
+	calls := 0
+	double := func(i int) int {
+		calls++
+		return i * 2
+	}
+
+	seq := Map(double, FromSlice(1, 2, 3, 4, 5))
+	if calls != 0 {
+		t.Errorf("Expected no calls before consuming, but got %d", calls)
+	}
+
+	result := Collect(Take(2, seq))
+	if !reflect.DeepEqual(result, []int{2, 4}) {
+		t.Errorf("Expected %v, but got %v", []int{2, 4}, result)
+	}
+
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, but got %d", calls)
+	}
+}
+
+func TestMapSkip(t *testing.T) {
+	callback := func(s string) (int, bool) {
+		return len(s), s == ""
+	}
+	items := []string{"a", "", "abc", "", "ab"}
+
+	expected := slice.MapSkip(callback, items...)
+	result := Collect(MapSkip(callback, FromSlice(items...)))
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestTakeSkipTakeWhile(t *testing.T) {
+	tests := []struct {
+		name string
+		seq  func() []int
+		want []int
+	}{
+		{
+			name: "Take fewer than available",
+			seq:  func() []int { return Collect(Take(3, FromSlice(1, 2, 3, 4, 5))) },
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "Take more than available",
+			seq:  func() []int { return Collect(Take(10, FromSlice(1, 2))) },
+			want: []int{1, 2},
+		},
+		{
+			name: "Take zero",
+			seq:  func() []int { return Collect(Take(0, FromSlice(1, 2))) },
+			want: []int{},
+		},
+		{
+			name: "Skip",
+			seq:  func() []int { return Collect(Skip(2, FromSlice(1, 2, 3, 4))) },
+			want: []int{3, 4},
+		},
+		{
+			name: "Skip past the end",
+			seq:  func() []int { return Collect(Skip(5, FromSlice(1, 2))) },
+			want: []int{},
+		},
+		{
+			name: "TakeWhile",
+			seq:  func() []int { return Collect(TakeWhile(func(i int) bool { return i < 3 }, FromSlice(1, 2, 3, 1))) },
+			want: []int{1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.seq()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChunk(t *testing.T) {
+	items := []float64{1.1, 2.2, 3.3, 4.4, 5.5}
+
+	expected := slice.Divide(2, items...)
+	result := Collect(Chunk(2, FromSlice(items...)))
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case: exact multiple of the chunk size
+	result2 := Collect(Chunk(3, FromSlice(1, 2, 3, 4, 5, 6)))
+	expected2 := [][]int{{1, 2, 3}, {4, 5, 6}}
+	if !reflect.DeepEqual(result2, expected2) {
+		t.Errorf("Expected %v, but got %v", expected2, result2)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected Chunk to panic on a non-positive size")
+		}
+	}()
+	Chunk(0, FromSlice(1))
+}
+
+func TestFromMap(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	keys := Collect(Keys(m))
+	sort.Strings(keys)
+	if !reflect.DeepEqual(keys, []string{"a", "b", "c"}) {
+		t.Errorf("Expected %v, but got %v", []string{"a", "b", "c"}, keys)
+	}
+
+	odd := CollectMap(Filter2(func(_ string, v int) bool { return v%2 == 1 }, FromMap(m)))
+	if !reflect.DeepEqual(odd, map[string]int{"a": 1, "c": 3}) {
+		t.Errorf("Expected %v, but got %v", map[string]int{"a": 1, "c": 3}, odd)
+	}
+
+	sum := Reduce(0, func(acc, v int) int { return acc + v }, Vals(m))
+	if sum != 6 {
+		t.Errorf("Expected 6, but got %d", sum)
+	}
+}
+
+func TestFromChan(t *testing.T) {
+	ch := make(chan int, 5)
+	for i := 1; i <= 5; i++ {
+		ch <- i
+	}
+	close(ch)
+
+	result := Collect(Filter(func(i int) bool { return i > 2 }, FromChan(ch)))
+	if !reflect.DeepEqual(result, []int{3, 4, 5}) {
+		t.Errorf("Expected %v, but got %v", []int{3, 4, 5}, result)
+	}
+}
+
+func TestIndexed(t *testing.T) {
+	result := Collect(Map2(func(i int, s string) string {
+		return string(rune('0'+i)) + s
+	}, Indexed("a", "b")))
+	if !reflect.DeepEqual(result, []string{"0a", "1b"}) {
+		t.Errorf("Expected %v, but got %v", []string{"0a", "1b"}, result)
+	}
+}
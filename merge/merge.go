@@ -0,0 +1,371 @@
+package merge
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrInvalidStrategy is returned when a merge tag names an unknown strategy
+// or a strategy that does not apply to the kind of its field, such as append
+// on a string.
+var ErrInvalidStrategy = errors.New("merge: invalid strategy")
+
+// TagName is the struct tag read to pick the strategy of a field, for
+// example `merge:"append"`. The tag `merge:"-"` keeps the value of dst.
+const TagName = "merge"
+
+// Strategy decides how a value of src is combined with the value of dst.
+type Strategy int
+
+const (
+	// Deep merges structs field by field, maps key by key and the targets of
+	// pointers, and falls back to SkipZero for other kinds. Structs without
+	// exported fields, such as time.Time, are merged with SkipZero as a
+	// whole. A type that
+	// implements types.MergingHandler is merged with its own Merge method.
+	Deep Strategy = iota
+	// Override always takes the value of src.
+	Override
+	// KeepFirst keeps the value of dst unless it is the zero value.
+	KeepFirst
+	// SkipZero takes the value of src unless it is the zero value, or an
+	// empty slice or map.
+	SkipZero
+	// Append concatenates the slice of src to the slice of dst.
+	Append
+	// AppendUnique appends the elements of src that are not already in the
+	// slice of dst.
+	AppendUnique
+)
+
+var strategyNames = map[Strategy]string{
+	Deep:         "deep",
+	Override:     "override",
+	KeepFirst:    "keepfirst",
+	SkipZero:     "skipzero",
+	Append:       "append",
+	AppendUnique: "appendunique",
+}
+
+// String returns the tag value of the strategy.
+func (s Strategy) String() string {
+	if name, ok := strategyNames[s]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("Strategy(%d)", int(s))
+}
+
+func parseStrategy(tag string) (Strategy, bool) {
+	for s, name := range strategyNames {
+		if name == tag {
+			return s, true
+		}
+	}
+
+	return 0, false
+}
+
+type config struct {
+	strategy Strategy
+}
+
+// Option configures a merge.
+type Option func(*config)
+
+// WithStrategy sets the strategy used for values that have no merge tag:
+// the top-level value, untagged struct fields and map entries. It defaults
+// to Deep.
+func WithStrategy(s Strategy) Option {
+	return func(c *config) {
+		c.strategy = s
+	}
+}
+
+// Merge returns the result of merging src into dst. Neither dst nor src is
+// modified, but values that are not merged, such as a map only present in
+// one of them, are shared with the result.
+//
+// Struct fields pick their strategy with a merge tag:
+//
+//	type Config struct {
+//		Name    string            // skipzero through the default Deep
+//		Hosts   []string          `merge:"appendunique"`
+//		Labels  map[string]string // merged key by key
+//		Version int               `merge:"keepfirst"`
+//		Secret  string            `merge:"-"`
+//	}
+//
+// Unexported fields always keep the value of dst, unless the struct has no
+// exported field at all and is then taken as a whole.
+func Merge[T any](dst, src T, opts ...Option) (T, error) {
+	c := &config{strategy: Deep}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	v, err := c.merge(reflect.ValueOf(&dst).Elem(), reflect.ValueOf(&src).Elem(), c.strategy, "")
+	if err != nil {
+		return dst, err
+	}
+
+	var result T
+	reflect.ValueOf(&result).Elem().Set(v)
+
+	return result, nil
+}
+
+// Reduce merges each layer into def in order, so later layers take
+// precedence. It is the reflection-based counterpart of slice.ReduceMergeFn.
+func Reduce[T any](def T, layers []T, opts ...Option) (T, error) {
+	result := def
+	for _, layer := range layers {
+		var err error
+		if result, err = Merge(result, layer, opts...); err != nil {
+			return def, err
+		}
+	}
+
+	return result, nil
+}
+
+func (c *config) merge(dst, src reflect.Value, s Strategy, path string) (reflect.Value, error) {
+	switch s {
+	case Override:
+		return src, nil
+	case KeepFirst:
+		if dst.IsZero() {
+			return src, nil
+		}
+
+		return dst, nil
+	case SkipZero:
+		if isEmpty(src) {
+			return dst, nil
+		}
+
+		return src, nil
+	case Append, AppendUnique:
+		if dst.Kind() != reflect.Slice {
+			return dst, invalid(s.String(), dst.Type(), path)
+		}
+
+		return appendSlices(dst, src, s == AppendUnique), nil
+	case Deep:
+		return c.deep(dst, src, path)
+	}
+
+	return dst, invalid(s.String(), dst.Type(), path)
+}
+
+func (c *config) deep(dst, src reflect.Value, path string) (reflect.Value, error) {
+	t := dst.Type()
+
+	if t.Kind() == reflect.Pointer || t.Kind() == reflect.Map {
+		if src.IsNil() {
+			return dst, nil
+		}
+
+		if dst.IsNil() {
+			return src, nil
+		}
+	}
+
+	if hasMergeMethod(t) {
+		return dst.MethodByName("Merge").Call([]reflect.Value{src})[0], nil
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		if opaque(t) {
+			break
+		}
+
+		result := reflect.New(t).Elem()
+		result.Set(dst)
+
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+
+			s := c.strategy
+			if tag, ok := f.Tag.Lookup(TagName); ok {
+				if tag == "-" {
+					continue
+				}
+
+				if s, ok = parseStrategy(tag); !ok {
+					return dst, invalid(tag, f.Type, join(path, f.Name))
+				}
+			}
+
+			v, err := c.merge(dst.Field(i), src.Field(i), s, join(path, f.Name))
+			if err != nil {
+				return dst, err
+			}
+
+			result.Field(i).Set(v)
+		}
+
+		return result, nil
+	case reflect.Map:
+		result := reflect.MakeMapWithSize(t, dst.Len()+src.Len())
+
+		iter := dst.MapRange()
+		for iter.Next() {
+			result.SetMapIndex(iter.Key(), iter.Value())
+		}
+
+		iter = src.MapRange()
+		for iter.Next() {
+			k, v := iter.Key(), iter.Value()
+
+			if cur := dst.MapIndex(k); cur.IsValid() {
+				var err error
+				if v, err = c.merge(cur, v, c.strategy, fmt.Sprintf("%s[%v]", path, k)); err != nil {
+					return dst, err
+				}
+			}
+
+			result.SetMapIndex(k, v)
+		}
+
+		return result, nil
+	case reflect.Pointer:
+		v, err := c.deep(dst.Elem(), src.Elem(), path)
+		if err != nil {
+			return dst, err
+		}
+
+		result := reflect.New(t.Elem())
+		result.Elem().Set(v)
+
+		return result, nil
+	}
+
+	return c.merge(dst, src, SkipZero, path)
+}
+
+// opaque reports whether the struct type t has no exported field, such as
+// time.Time or netip.Addr. Its state is private, so it is merged as a whole.
+func opaque(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).IsExported() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hasMergeMethod reports whether t implements types.MergingHandler[t], that
+// is whether it has a method Merge(t) t.
+func hasMergeMethod(t reflect.Type) bool {
+	if t.Kind() == reflect.Interface {
+		return false
+	}
+
+	m, ok := t.MethodByName("Merge")
+	if !ok {
+		return false
+	}
+
+	// m.Type includes the receiver as its first input.
+	return m.Type.NumIn() == 2 && m.Type.In(1) == t &&
+		m.Type.NumOut() == 1 && m.Type.Out(0) == t
+}
+
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	}
+
+	return v.IsZero()
+}
+
+func appendSlices(dst, src reflect.Value, unique bool) reflect.Value {
+	result := reflect.MakeSlice(dst.Type(), 0, dst.Len()+src.Len())
+	result = reflect.AppendSlice(result, dst)
+
+	if !unique {
+		return reflect.AppendSlice(result, src)
+	}
+
+	if hashable(dst.Type().Elem()) {
+		seen := make(map[any]struct{}, result.Len()+src.Len())
+		for i := 0; i < result.Len(); i++ {
+			seen[result.Index(i).Interface()] = struct{}{}
+		}
+
+		for i := 0; i < src.Len(); i++ {
+			v := src.Index(i)
+			if _, ok := seen[v.Interface()]; !ok {
+				seen[v.Interface()] = struct{}{}
+				result = reflect.Append(result, v)
+			}
+		}
+
+		return result
+	}
+
+	for i := 0; i < src.Len(); i++ {
+		v := src.Index(i)
+		if !containsDeep(result, v) {
+			result = reflect.Append(result, v)
+		}
+	}
+
+	return result
+}
+
+// hashable reports whether every value of t can be used as a map key. A
+// comparable type holding interfaces, even in a nested field, may hold an
+// unhashable dynamic value.
+func hashable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return false
+	case reflect.Array:
+		return hashable(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !hashable(t.Field(i).Type) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return t.Comparable()
+}
+
+func containsDeep(items, v reflect.Value) bool {
+	for i := 0; i < items.Len(); i++ {
+		if reflect.DeepEqual(items.Index(i).Interface(), v.Interface()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+func invalid(strategy string, t reflect.Type, path string) error {
+	if path == "" {
+		path = "value"
+	}
+
+	return fmt.Errorf("%w %q for %s of type %s", ErrInvalidStrategy, strategy, path, t)
+}
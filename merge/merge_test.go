@@ -0,0 +1,206 @@
+package merge
+
+import (
+	"errors"
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type limits struct {
+	CPU    int
+	Memory int
+}
+
+type server struct {
+	Name    string
+	Hosts   []string `merge:"appendunique"`
+	Plugins []string `merge:"append"`
+	Labels  map[string]string
+	Version int    `merge:"keepfirst"`
+	Debug   bool   `merge:"override"`
+	Secret  string `merge:"-"`
+	Limits  *limits
+	Extra   map[string]limits
+	note    string
+}
+
+func TestMergeStruct(t *testing.T) {
+	base := server{
+		Name:    "base",
+		Hosts:   []string{"a", "b"},
+		Plugins: []string{"auth"},
+		Labels:  map[string]string{"env": "dev", "team": "core"},
+		Version: 1,
+		Debug:   true,
+		Secret:  "s3cret",
+		Limits:  &limits{CPU: 1, Memory: 512},
+		Extra:   map[string]limits{"x": {CPU: 1, Memory: 1}},
+		note:    "kept",
+	}
+
+	override := server{
+		Hosts:   []string{"b", "c"},
+		Plugins: []string{"auth", "log"},
+		Labels:  map[string]string{"env": "prod"},
+		Version: 2,
+		Secret:  "leaked",
+		Limits:  &limits{Memory: 1024},
+		Extra:   map[string]limits{"x": {CPU: 2}, "y": {CPU: 3}},
+	}
+
+	got, err := Merge(base, override)
+	if err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+
+	expected := server{
+		Name:    "base",
+		Hosts:   []string{"a", "b", "c"},
+		Plugins: []string{"auth", "auth", "log"},
+		Labels:  map[string]string{"env": "prod", "team": "core"},
+		Version: 1,
+		Debug:   false,
+		Secret:  "s3cret",
+		Limits:  &limits{CPU: 1, Memory: 1024},
+		Extra:   map[string]limits{"x": {CPU: 2, Memory: 1}, "y": {CPU: 3}},
+		note:    "kept",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, but got %+v", expected, got)
+	}
+
+	// The inputs must be left untouched.
+	if base.Labels["env"] != "dev" || base.Limits.Memory != 512 || len(base.Hosts) != 2 {
+		t.Errorf("Expected Merge not to modify dst, but got %+v", base)
+	}
+}
+
+type version struct {
+	Major, Minor int
+}
+
+// Merge keeps the highest version, which no tag strategy can express.
+func (v version) Merge(next version) version {
+	if next.Major > v.Major || next.Major == v.Major && next.Minor > v.Minor {
+		return next
+	}
+
+	return v
+}
+
+type release struct {
+	Version version
+	Name    string
+}
+
+func TestMergeOpaqueStructs(t *testing.T) {
+	type endpoint struct {
+		Deadline time.Time
+		Addr     netip.Addr
+	}
+
+	deadline := time.Unix(100, 0)
+	addr := netip.MustParseAddr("1.2.3.4")
+
+	tests := []struct {
+		name     string
+		dst, src endpoint
+		want     endpoint
+	}{
+		{"from zero", endpoint{}, endpoint{Deadline: deadline, Addr: addr}, endpoint{Deadline: deadline, Addr: addr}},
+		{"zero src", endpoint{Deadline: deadline, Addr: addr}, endpoint{}, endpoint{Deadline: deadline, Addr: addr}},
+		{"override", endpoint{Deadline: time.Unix(1, 0), Addr: netip.IPv6Loopback()}, endpoint{Deadline: deadline, Addr: addr}, endpoint{Deadline: deadline, Addr: addr}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Merge(tt.dst, tt.src)
+			if err != nil {
+				t.Fatalf("Merge() error = %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("Expected %+v, but got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAppendUniqueInterfaces(t *testing.T) {
+	type tag struct{ X any }
+	type doc struct {
+		Tags []tag `merge:"appendunique"`
+	}
+
+	dst := doc{Tags: []tag{{X: []int{1}}, {X: "a"}}}
+	src := doc{Tags: []tag{{X: []int{1}}, {X: []int{2}}, {X: "a"}}}
+
+	got, err := Merge(dst, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []tag{{X: []int{1}}, {X: "a"}, {X: []int{2}}}
+	if !reflect.DeepEqual(got.Tags, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got.Tags)
+	}
+}
+
+func TestMergingHandlerFallback(t *testing.T) {
+	got, err := Reduce(release{Name: "base"}, []release{
+		{Version: version{1, 4}},
+		{Version: version{1, 2}, Name: "next"},
+		{Version: version{0, 9}},
+	})
+	if err != nil {
+		t.Fatalf("Reduce() error = %v", err)
+	}
+
+	expected := release{Version: version{1, 4}, Name: "next"}
+	if got != expected {
+		t.Errorf("Expected %+v, but got %+v", expected, got)
+	}
+}
+
+func TestWithStrategy(t *testing.T) {
+	got, err := Merge(map[string]int{"a": 1, "b": 2}, map[string]int{"a": 0}, WithStrategy(Override))
+	if err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+
+	// Override applies to the whole value, not to each entry.
+	if !reflect.DeepEqual(got, map[string]int{"a": 0}) {
+		t.Errorf("Expected %v, but got %v", map[string]int{"a": 0}, got)
+	}
+
+	got, _ = Merge(map[string]int{"a": 1, "b": 2}, map[string]int{"a": 0, "c": 3})
+	if !reflect.DeepEqual(got, map[string]int{"a": 1, "b": 2, "c": 3}) {
+		t.Errorf("Expected zero values to be skipped, but got %v", got)
+	}
+}
+
+func TestInvalidStrategy(t *testing.T) {
+	type badKind struct {
+		Name string `merge:"append"`
+	}
+
+	type unknown struct {
+		Name string `merge:"replace"`
+	}
+
+	if _, err := Merge(badKind{"a"}, badKind{"b"}); !errors.Is(err, ErrInvalidStrategy) {
+		t.Errorf("Expected ErrInvalidStrategy, but got %v", err)
+	}
+
+	got, err := Merge(unknown{"a"}, unknown{"b"})
+	if !errors.Is(err, ErrInvalidStrategy) {
+		t.Errorf("Expected ErrInvalidStrategy, but got %v", err)
+	}
+
+	if got.Name != "a" {
+		t.Errorf("Expected dst to be returned on error, but got %+v", got)
+	}
+}
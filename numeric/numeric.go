@@ -0,0 +1,366 @@
+package numeric
+
+import (
+	"cmp"
+	"errors"
+	"math"
+	"slices"
+)
+
+var (
+	// ErrEmpty is returned by aggregations that are undefined on an empty
+	// input, such as Min or Mean.
+	ErrEmpty = errors.New("numeric: empty input")
+	// ErrOverflow is returned by the checked variants when the result does
+	// not fit in the integer type.
+	ErrOverflow = errors.New("numeric: integer overflow")
+	// ErrInvalidArgument is returned for a percentile outside [0, 100], or a
+	// histogram with less than one bucket or with non-finite items.
+	ErrInvalidArgument = errors.New("numeric: invalid argument")
+)
+
+// Signed is a constraint for signed integer types.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is a constraint for unsigned integer types.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer is a constraint for integer types.
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Float is a constraint for floating-point types.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is a constraint for integer and floating-point types.
+type Number interface {
+	Integer | Float
+}
+
+// Sum returns the sum of items, or 0 for an empty input.
+//
+// Integer sums wrap around silently on overflow; use SumChecked to detect
+// it. Float sums accumulate rounding errors; use KahanSum for long inputs.
+func Sum[T Number](items ...T) T {
+	var result T
+	for _, v := range items {
+		result += v
+	}
+
+	return result
+}
+
+// SumChecked returns the sum of items, or ErrOverflow if it does not fit in
+// T.
+func SumChecked[T Integer](items ...T) (T, error) {
+	var result T
+	for _, v := range items {
+		sum := result + v
+		if (v > 0 && sum < result) || (v < 0 && sum > result) {
+			return 0, ErrOverflow
+		}
+
+		result = sum
+	}
+
+	return result, nil
+}
+
+// KahanSum returns the sum of items using Kahan-Babuska compensated
+// summation, which keeps the rounding error independent of the number of
+// items.
+func KahanSum[T Float](items ...T) T {
+	var sum, compensation float64
+	for _, x := range items {
+		v := float64(x)
+		t := sum + v
+
+		if math.Abs(sum) >= math.Abs(v) {
+			compensation += (sum - t) + v
+		} else {
+			compensation += (v - t) + sum
+		}
+
+		sum = t
+	}
+
+	return T(sum + compensation)
+}
+
+// Product returns the product of items, or 1 for an empty input.
+//
+// Integer products wrap around silently on overflow; use ProductChecked to
+// detect it.
+func Product[T Number](items ...T) T {
+	result := T(1)
+	for _, v := range items {
+		result *= v
+	}
+
+	return result
+}
+
+// ProductChecked returns the product of items, or ErrOverflow if it does not
+// fit in T.
+func ProductChecked[T Integer](items ...T) (T, error) {
+	result := T(1)
+	for _, v := range items {
+		if result == 0 || v == 0 {
+			result = 0
+			continue
+		}
+
+		p := result * v
+		// The sign check catches MinInt * -1, for which the division check
+		// is fooled.
+		if p/v != result || (p < 0) != ((result < 0) != (v < 0)) {
+			return 0, ErrOverflow
+		}
+
+		result = p
+	}
+
+	return result, nil
+}
+
+// Min returns the smallest item, or ErrEmpty for an empty input. A NaN
+// propagates like in the built-in min.
+func Min[T cmp.Ordered](items ...T) (T, error) {
+	if len(items) == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+
+	return slices.Min(items), nil
+}
+
+// Max returns the largest item, or ErrEmpty for an empty input. A NaN
+// propagates like in the built-in max.
+func Max[T cmp.Ordered](items ...T) (T, error) {
+	if len(items) == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+
+	return slices.Max(items), nil
+}
+
+// MinBy returns the first item with the smallest key, or ErrEmpty for an
+// empty input. The key is computed once per item.
+func MinBy[T any, K cmp.Ordered](key func(T) K, items ...T) (T, error) {
+	return extremeBy(key, -1, items)
+}
+
+// MaxBy returns the first item with the largest key, or ErrEmpty for an
+// empty input. The key is computed once per item.
+func MaxBy[T any, K cmp.Ordered](key func(T) K, items ...T) (T, error) {
+	return extremeBy(key, 1, items)
+}
+
+func extremeBy[T any, K cmp.Ordered](key func(T) K, sign int, items []T) (T, error) {
+	if len(items) == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+
+	result, best := items[0], key(items[0])
+	for _, v := range items[1:] {
+		if k := key(v); cmp.Compare(k, best)*sign > 0 {
+			result, best = v, k
+		}
+	}
+
+	return result, nil
+}
+
+// Mean returns the arithmetic mean of items, or ErrEmpty for an empty
+// input. It is computed in float64 with compensated summation, so integer
+// inputs cannot overflow.
+func Mean[T Number](items ...T) (float64, error) {
+	if len(items) == 0 {
+		return 0, ErrEmpty
+	}
+
+	return KahanSum(toFloats(items)...) / float64(len(items)), nil
+}
+
+// Median returns the middle value of items, or the mean of the two middle
+// values for an even count. It returns ErrEmpty for an empty input and does
+// not modify items.
+func Median[T Number](items ...T) (float64, error) {
+	return Percentile(50, items...)
+}
+
+// Mode returns the most frequent item, or ErrEmpty for an empty input. Ties
+// are broken by first appearance.
+func Mode[T comparable](items ...T) (T, error) {
+	if len(items) == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+
+	counts := make(map[T]int, len(items))
+	best := 0
+
+	for _, v := range items {
+		counts[v]++
+		best = max(best, counts[v])
+	}
+
+	result := items[0]
+	for _, v := range items {
+		if counts[v] == best {
+			result = v
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// Variance returns the population variance of items, or ErrEmpty for an
+// empty input. It uses Welford's algorithm, which is numerically stable.
+func Variance[T Number](items ...T) (float64, error) {
+	m2, n, err := welford(items)
+	if err != nil {
+		return 0, err
+	}
+
+	return m2 / float64(n), nil
+}
+
+// SampleVariance returns the unbiased sample variance of items, dividing by
+// n-1. It returns ErrEmpty for fewer than two items.
+func SampleVariance[T Number](items ...T) (float64, error) {
+	if len(items) < 2 {
+		return 0, ErrEmpty
+	}
+
+	m2, n, _ := welford(items)
+
+	return m2 / float64(n-1), nil
+}
+
+// StdDev returns the population standard deviation of items, or ErrEmpty
+// for an empty input.
+func StdDev[T Number](items ...T) (float64, error) {
+	v, err := Variance(items...)
+
+	return math.Sqrt(v), err
+}
+
+// SampleStdDev returns the sample standard deviation of items, or ErrEmpty
+// for fewer than two items.
+func SampleStdDev[T Number](items ...T) (float64, error) {
+	v, err := SampleVariance(items...)
+
+	return math.Sqrt(v), err
+}
+
+func welford[T Number](items []T) (m2 float64, n int, err error) {
+	if len(items) == 0 {
+		return 0, 0, ErrEmpty
+	}
+
+	var mean float64
+	for _, x := range items {
+		n++
+		v := float64(x)
+		delta := v - mean
+		mean += delta / float64(n)
+		m2 += delta * (v - mean)
+	}
+
+	return m2, n, nil
+}
+
+// Percentile returns the p-th percentile of items, for p in [0, 100],
+// interpolating linearly between the closest ranks. It returns ErrEmpty for
+// an empty input, ErrInvalidArgument for p out of range, and does not modify
+// items.
+func Percentile[T Number](p float64, items ...T) (float64, error) {
+	if len(items) == 0 {
+		return 0, ErrEmpty
+	}
+
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		return 0, ErrInvalidArgument
+	}
+
+	sorted := toFloats(items)
+	slices.Sort(sorted)
+
+	rank := p / 100 * float64(len(sorted)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+
+	return sorted[lo] + (sorted[hi]-sorted[lo])*(rank-float64(lo)), nil
+}
+
+// Bucket is one bin of a histogram. It counts the values in [Low, High),
+// except for the last bucket, which includes High.
+type Bucket struct {
+	Low   float64
+	High  float64
+	Count int
+}
+
+// Histogram splits the range between the smallest and largest item into n
+// buckets of equal width and counts the items in each. It returns ErrEmpty
+// for an empty input and ErrInvalidArgument when n is less than 1 or when an
+// item is infinite or NaN, since the range would have no finite bounds.
+func Histogram[T Number](n int, items ...T) ([]Bucket, error) {
+	if n < 1 {
+		return nil, ErrInvalidArgument
+	}
+
+	if len(items) == 0 {
+		return nil, ErrEmpty
+	}
+
+	values := toFloats(items)
+	for _, v := range values {
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			return nil, ErrInvalidArgument
+		}
+	}
+
+	lo, hi := slices.Min(values), slices.Max(values)
+	width := (hi - lo) / float64(n)
+
+	result := make([]Bucket, n)
+	for i := range result {
+		result[i].Low = lo + float64(i)*width
+		result[i].High = lo + float64(i+1)*width
+	}
+	result[n-1].High = hi
+
+	for _, v := range values {
+		// The index is clamped, as rounding, or a range too wide for a
+		// float64, may put a value outside the buckets.
+		i := n - 1
+		if f := (v - lo) / width; width > 0 && f < float64(n-1) {
+			i = max(int(f), 0)
+		}
+
+		result[i].Count++
+	}
+
+	return result, nil
+}
+
+func toFloats[T Number](items []T) []float64 {
+	result := make([]float64, len(items))
+	for i, v := range items {
+		result[i] = float64(v)
+	}
+
+	return result
+}
@@ -0,0 +1,206 @@
+package numeric
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSumProduct(t *testing.T) {
+	if got := Sum(1, 2, 3, 4); got != 10 {
+		t.Errorf("Sum() = %v, want 10", got)
+	}
+
+	if got := Sum[float64](); got != 0 {
+		t.Errorf("Sum() = %v, want 0", got)
+	}
+
+	if got := Product(1, 2, 3, 4); got != 24 {
+		t.Errorf("Product() = %v, want 24", got)
+	}
+
+	if got := Product[int](); got != 1 {
+		t.Errorf("Product() = %v, want 1", got)
+	}
+}
+
+func TestChecked(t *testing.T) {
+	tests := []struct {
+		name    string
+		fn      func() (int8, error)
+		want    int8
+		wantErr error
+	}{
+		{"sum", func() (int8, error) { return SumChecked[int8](100, 27) }, 127, nil},
+		{"sum overflow", func() (int8, error) { return SumChecked[int8](100, 28) }, 0, ErrOverflow},
+		{"sum underflow", func() (int8, error) { return SumChecked[int8](-100, -29) }, 0, ErrOverflow},
+		{"sum back in range", func() (int8, error) { return SumChecked[int8](100, -50, 70) }, 120, nil},
+		{"product", func() (int8, error) { return ProductChecked[int8](-8, 16) }, -128, nil},
+		{"product overflow", func() (int8, error) { return ProductChecked[int8](16, 8) }, 0, ErrOverflow},
+		{"min times minus one", func() (int8, error) { return ProductChecked[int8](-128, -1) }, 0, ErrOverflow},
+		{"minus one times min", func() (int8, error) { return ProductChecked[int8](-1, -128) }, 0, ErrOverflow},
+		{"zero", func() (int8, error) { return ProductChecked[int8](0, 100, 100) }, 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn()
+			if got != tt.want || !errors.Is(err, tt.wantErr) {
+				t.Errorf("got %v, %v, want %v, %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := SumChecked[uint8](200, 56); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow for unsigned sums, but got %v", err)
+	}
+
+	if _, err := ProductChecked[uint8](16, 16); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow for unsigned products, but got %v", err)
+	}
+}
+
+func TestKahanSum(t *testing.T) {
+	items := make([]float64, 0, 10001)
+	items = append(items, 1)
+	for i := 0; i < 10000; i++ {
+		items = append(items, 1e-16)
+	}
+
+	if got := KahanSum(items...); math.Abs(got-(1+1e-12)) > 1e-18 {
+		t.Errorf("KahanSum() = %v, want %v", got, 1+1e-12)
+	}
+
+	if got := KahanSum(1e100, 1.0, -1e100); got != 1 {
+		t.Errorf("KahanSum() = %v, want 1", got)
+	}
+}
+
+type item struct {
+	Name  string
+	Price int
+}
+
+func TestMinMax(t *testing.T) {
+	if got, err := Min(3, 1, 2); got != 1 || err != nil {
+		t.Errorf("Min() = %v, %v", got, err)
+	}
+
+	if got, err := Max("b", "c", "a"); got != "c" || err != nil {
+		t.Errorf("Max() = %v, %v", got, err)
+	}
+
+	if _, err := Min[int](); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected ErrEmpty, but got %v", err)
+	}
+
+	items := []item{{"a", 3}, {"b", 1}, {"c", 3}, {"d", 1}}
+	price := func(i item) int { return i.Price }
+
+	if got, _ := MinBy(price, items...); got.Name != "b" {
+		t.Errorf("MinBy() = %v, want b", got)
+	}
+
+	if got, _ := MaxBy(price, items...); got.Name != "a" {
+		t.Errorf("MaxBy() = %v, want a", got)
+	}
+
+	if _, err := MaxBy(price); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected ErrEmpty, but got %v", err)
+	}
+}
+
+func TestStatistics(t *testing.T) {
+	items := []int{2, 4, 4, 4, 5, 5, 7, 9}
+
+	check := func(name string, got float64, err error, want float64) {
+		t.Helper()
+		if err != nil || math.Abs(got-want) > 1e-9 {
+			t.Errorf("%s = %v, %v, want %v", name, got, err, want)
+		}
+	}
+
+	mean, err := Mean(items...)
+	check("Mean()", mean, err, 5)
+
+	median, err := Median(items...)
+	check("Median()", median, err, 4.5)
+
+	median, err = Median(3, 1, 2)
+	check("Median()", median, err, 2)
+
+	variance, err := Variance(items...)
+	check("Variance()", variance, err, 4)
+
+	stddev, err := StdDev(items...)
+	check("StdDev()", stddev, err, 2)
+
+	sample, err := SampleVariance(items...)
+	check("SampleVariance()", sample, err, 32.0/7)
+
+	p, err := Percentile(25, 10, 20, 30, 40, 50)
+	check("Percentile(25)", p, err, 20)
+
+	p, err = Percentile(90, 10, 20, 30, 40, 50)
+	check("Percentile(90)", p, err, 46)
+
+	if mode, err := Mode(items...); mode != 4 || err != nil {
+		t.Errorf("Mode() = %v, %v, want 4", mode, err)
+	}
+
+	if mode, _ := Mode("b", "a", "a", "b"); mode != "b" {
+		t.Errorf("Expected ties to be broken by first appearance, but got %v", mode)
+	}
+
+	for name, fn := range map[string]func() (float64, error){
+		"Mean":           func() (float64, error) { return Mean[int]() },
+		"Median":         func() (float64, error) { return Median[int]() },
+		"Variance":       func() (float64, error) { return Variance[int]() },
+		"SampleVariance": func() (float64, error) { return SampleVariance(1) },
+	} {
+		if _, err := fn(); !errors.Is(err, ErrEmpty) {
+			t.Errorf("Expected %s to return ErrEmpty, but got %v", name, err)
+		}
+	}
+
+	if _, err := Percentile(101, 1, 2); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidArgument, but got %v", err)
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	got, err := Histogram(2, 0, 1, 2, 3, 4)
+	expected := []Bucket{
+		{Low: 0, High: 2, Count: 2},
+		{Low: 2, High: 4, Count: 3},
+	}
+
+	if err != nil || !reflect.DeepEqual(got, expected) {
+		t.Errorf("Histogram() = %v, %v, want %v", got, err, expected)
+	}
+
+	got, _ = Histogram(3, 5, 5)
+	if got[2].Count != 2 {
+		t.Errorf("Expected equal values to land in the last bucket, but got %v", got)
+	}
+
+	if _, err := Histogram(0, 1); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidArgument, but got %v", err)
+	}
+
+	if _, err := Histogram[int](1); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected ErrEmpty, but got %v", err)
+	}
+
+	for _, bad := range []float64{math.Inf(1), math.Inf(-1), math.NaN()} {
+		if _, err := Histogram(3, 1.0, 2.0, bad); !errors.Is(err, ErrInvalidArgument) {
+			t.Errorf("Expected ErrInvalidArgument for %v, but got %v", bad, err)
+		}
+	}
+
+	got, err = Histogram(2, -math.MaxFloat64, 0, math.MaxFloat64)
+	if err != nil || got[0].Count+got[1].Count != 3 {
+		t.Errorf("Expected every item to be counted over an overflowing range, but got %v, %v", got, err)
+	}
+}
@@ -0,0 +1,204 @@
+package option
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Option holds either a value (Some) or nothing (None).
+//
+// It replaces the (value, found) tuple convention: the zero value is None,
+// and the value can only be read through methods that make the missing case
+// explicit.
+type Option[T any] struct {
+	value T
+	ok    bool
+}
+
+// Some returns an Option holding v.
+func Some[T any](v T) Option[T] {
+	return Option[T]{value: v, ok: true}
+}
+
+// None returns an empty Option.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// FromPtr returns Some(*p) when p is not nil and None otherwise.
+func FromPtr[T any](p *T) Option[T] {
+	if p == nil {
+		return None[T]()
+	}
+
+	return Some(*p)
+}
+
+// FromTuple converts the (value, found) convention into an Option.
+func FromTuple[T any](v T, ok bool) Option[T] {
+	if !ok {
+		return None[T]()
+	}
+
+	return Some(v)
+}
+
+// IsSome reports whether the Option holds a value.
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+// IsNone reports whether the Option is empty.
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+// IsZero reports whether the Option is empty. Since Go 1.24 it lets
+// encoding/json drop None fields tagged with omitzero.
+func (o Option[T]) IsZero() bool {
+	return !o.ok
+}
+
+// Get returns the value and whether it is present.
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+// Ptr returns a pointer to a copy of the value, or nil for None.
+func (o Option[T]) Ptr() *T {
+	if !o.ok {
+		return nil
+	}
+
+	v := o.value
+	return &v
+}
+
+// Unwrap returns the value and panics if the Option is None.
+func (o Option[T]) Unwrap() T {
+	if !o.ok {
+		panic("option: Unwrap called on None")
+	}
+
+	return o.value
+}
+
+// UnwrapOr returns the value, or def if the Option is None.
+func (o Option[T]) UnwrapOr(def T) T {
+	if !o.ok {
+		return def
+	}
+
+	return o.value
+}
+
+// UnwrapOrElse returns the value, or the result of fn if the Option is None.
+// fn is only called when needed.
+func (o Option[T]) UnwrapOrElse(fn func() T) T {
+	if !o.ok {
+		return fn()
+	}
+
+	return o.value
+}
+
+// Filter returns o if it holds a value that satisfies the predicate and None
+// otherwise.
+func (o Option[T]) Filter(predicate func(T) bool) Option[T] {
+	if !o.ok || !predicate(o.value) {
+		return None[T]()
+	}
+
+	return o
+}
+
+// Or returns o if it holds a value and other otherwise.
+func (o Option[T]) Or(other Option[T]) Option[T] {
+	if o.ok {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns o if it holds a value and the result of fn otherwise. fn is
+// only called when needed.
+func (o Option[T]) OrElse(fn func() Option[T]) Option[T] {
+	if o.ok {
+		return o
+	}
+
+	return fn()
+}
+
+// String implements fmt.Stringer.
+func (o Option[T]) String() string {
+	if !o.ok {
+		return "None"
+	}
+
+	return fmt.Sprintf("Some(%v)", o.value)
+}
+
+// MarshalJSON encodes None as null and Some(v) as v.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.ok {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes null as None and any other value as Some.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*o = Some(v)
+	return nil
+}
+
+// Scan implements sql.Scanner: SQL NULL becomes None.
+func (o *Option[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+
+	*o = FromTuple(n.V, n.Valid)
+	return nil
+}
+
+// Value implements driver.Valuer: None becomes SQL NULL.
+func (o Option[T]) Value() (driver.Value, error) {
+	return sql.Null[T]{V: o.value, Valid: o.ok}.Value()
+}
+
+// Map applies callback to the value of o, if any.
+func Map[T, R any](o Option[T], callback func(T) R) Option[R] {
+	if !o.ok {
+		return None[R]()
+	}
+
+	return Some(callback(o.value))
+}
+
+// AndThen applies callback to the value of o, if any, and returns its
+// result. It chains lookups that may each fail.
+func AndThen[T, R any](o Option[T], callback func(T) Option[R]) Option[R] {
+	if !o.ok {
+		return None[R]()
+	}
+
+	return callback(o.value)
+}
@@ -0,0 +1,147 @@
+package option
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+)
+
+func TestSomeNone(t *testing.T) {
+	some := Some(42)
+	none := None[int]()
+
+	if !some.IsSome() || some.IsNone() || some.Unwrap() != 42 {
+		t.Errorf("Expected Some(42), but got %v", some)
+	}
+
+	if none.IsSome() || !none.IsNone() {
+		t.Errorf("Expected None, but got %v", none)
+	}
+
+	var zero Option[int]
+	if zero.IsSome() {
+		t.Errorf("Expected the zero value to be None")
+	}
+
+	if got := none.UnwrapOr(7); got != 7 {
+		t.Errorf("UnwrapOr() = %v, want 7", got)
+	}
+
+	if got := none.UnwrapOrElse(func() int { return 8 }); got != 8 {
+		t.Errorf("UnwrapOrElse() = %v, want 8", got)
+	}
+
+	if got := some.UnwrapOrElse(func() int { t.Error("fn must not be called"); return 0 }); got != 42 {
+		t.Errorf("UnwrapOrElse() = %v, want 42", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected Unwrap on None to panic")
+		}
+	}()
+	none.Unwrap()
+}
+
+func TestConversions(t *testing.T) {
+	v := 3
+	if got := FromPtr(&v); got.Unwrap() != 3 {
+		t.Errorf("FromPtr() = %v, want Some(3)", got)
+	}
+
+	if got := FromPtr[int](nil); got.IsSome() {
+		t.Errorf("FromPtr(nil) = %v, want None", got)
+	}
+
+	if got := FromTuple(5, false); got.IsSome() {
+		t.Errorf("FromTuple(5, false) = %v, want None", got)
+	}
+
+	if val, ok := Some("x").Get(); val != "x" || !ok {
+		t.Errorf("Get() = %v, %v, want x, true", val, ok)
+	}
+
+	p := Some(9).Ptr()
+	if p == nil || *p != 9 || None[int]().Ptr() != nil {
+		t.Errorf("Unexpected Ptr() result")
+	}
+}
+
+func TestCombinators(t *testing.T) {
+	parse := func(s string) Option[int] {
+		n, err := strconv.Atoi(s)
+		return FromTuple(n, err == nil)
+	}
+
+	tests := []struct {
+		name string
+		got  Option[int]
+		want Option[int]
+	}{
+		{name: "Map Some", got: Map(Some(2), func(i int) int { return i * 10 }), want: Some(20)},
+		{name: "Map None", got: Map(None[int](), func(i int) int { return i * 10 }), want: None[int]()},
+		{name: "AndThen valid", got: AndThen(Some("12"), parse), want: Some(12)},
+		{name: "AndThen invalid", got: AndThen(Some("x"), parse), want: None[int]()},
+		{name: "Filter keeps", got: Some(4).Filter(func(i int) bool { return i%2 == 0 }), want: Some(4)},
+		{name: "Filter drops", got: Some(3).Filter(func(i int) bool { return i%2 == 0 }), want: None[int]()},
+		{name: "Or", got: None[int]().Or(Some(1)), want: Some(1)},
+		{name: "OrElse", got: None[int]().OrElse(func() Option[int] { return Some(2) }), want: Some(2)},
+		{name: "OrElse on Some", got: Some(3).OrElse(func() Option[int] { return Some(2) }), want: Some(3)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSON(t *testing.T) {
+	type payload struct {
+		Name Option[string] `json:"name"`
+		Age  Option[int]    `json:"age"`
+	}
+
+	data, err := json.Marshal(payload{Name: Some("ann"), Age: None[int]()})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	if string(data) != `{"name":"ann","age":null}` {
+		t.Errorf("Marshal() = %s", data)
+	}
+
+	var decoded payload
+	if err := json.Unmarshal([]byte(`{"name":null,"age":31}`), &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if decoded.Name.IsSome() || decoded.Age != Some(31) {
+		t.Errorf("Unmarshal() = %+v", decoded)
+	}
+
+	if err := json.Unmarshal([]byte(`{"age":"x"}`), &decoded); err == nil {
+		t.Errorf("Expected an error for a mistyped value")
+	}
+}
+
+func TestSQL(t *testing.T) {
+	var o Option[int64]
+	if err := o.Scan(nil); err != nil || o.IsSome() {
+		t.Errorf("Scan(nil) = %v, %v", o, err)
+	}
+
+	if err := o.Scan(int64(12)); err != nil || o != Some(int64(12)) {
+		t.Errorf("Scan(12) = %v, %v", o, err)
+	}
+
+	if v, err := None[string]().Value(); v != nil || err != nil {
+		t.Errorf("Value() = %v, %v, want nil, nil", v, err)
+	}
+
+	if v, err := Some("a").Value(); v != "a" || err != nil {
+		t.Errorf("Value() = %v, %v, want a, nil", v, err)
+	}
+}
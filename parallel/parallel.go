@@ -0,0 +1,221 @@
+package parallel
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cirius-go/generic/result"
+	"github.com/cirius-go/generic/slice"
+)
+
+// IndexError reports the index of the element whose callback failed.
+type IndexError = result.IndexError
+
+// errStopped is returned by a unit of work that gave up because the context
+// was canceled. run does not report it as a failure of that unit.
+var errStopped = errors.New("parallel: stopped")
+
+// errFailed is the cause run cancels its context with after a failure, so
+// that the cancellation errors it provokes in other calls are not reported
+// as failures of their own.
+var errFailed = errors.New("parallel: another index failed")
+
+// workers normalizes the concurrency limit for n units of work.
+//
+// A non-positive limit means "one worker per available CPU".
+func workers(limit, n int) int {
+	if limit <= 0 {
+		limit = runtime.GOMAXPROCS(0)
+	}
+
+	if limit > n {
+		limit = n
+	}
+
+	return limit
+}
+
+// run calls fn for every index in [0, n) using at most limit goroutines.
+//
+// The first failure cancels the context handed to the remaining calls and no
+// new index is started afterwards. If several calls fail, the error with the
+// lowest index wins, so the reported failure does not depend on scheduling.
+// Calls that return context.Canceled after that first failure only honour
+// the cancellation and are not reported.
+func run(ctx context.Context, limit, n int, fn func(ctx context.Context, index int) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		next     atomic.Int64
+		done     atomic.Int64
+		firstErr *IndexError
+	)
+
+	for w := workers(limit, n); w > 0; w-- {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= n || ctx.Err() != nil {
+					return
+				}
+
+				err := fn(ctx, i)
+				if err == nil {
+					done.Add(1)
+					continue
+				}
+
+				if errors.Is(err, errStopped) ||
+					errors.Is(err, context.Canceled) && errors.Is(context.Cause(ctx), errFailed) {
+					return
+				}
+
+				mu.Lock()
+				if firstErr == nil || i < firstErr.Index {
+					firstErr = &IndexError{Index: i, Err: err}
+				}
+				mu.Unlock()
+
+				cancel(errFailed)
+				return
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	if int(done.Load()) == n {
+		return nil
+	}
+
+	// The parent context was canceled while the workers were running, so
+	// some indices were never processed.
+	return context.Cause(ctx)
+}
+
+// ParallelMap applies the callback to each item using at most limit
+// goroutines and returns the results in the same order as items.
+//
+// A non-positive limit uses one goroutine per available CPU. The returned
+// error is non-nil only when ctx is canceled before every item is mapped, in
+// which case the results are discarded.
+func ParallelMap[T, R any](ctx context.Context, limit int, callback func(context.Context, T) R, items ...T) ([]R, error) {
+	return ParallelMapTilError(ctx, limit, func(ctx context.Context, item T) (R, error) {
+		return callback(ctx, item), nil
+	}, items...)
+}
+
+// ParallelMapTilError applies the callback to each item using at most limit
+// goroutines and returns the results in the same order as items.
+//
+// The first callback error, or the cancellation of ctx, stops every worker.
+// A callback error is returned as an *IndexError carrying the index of the
+// failing item. Unlike slice.MapTilError no partial results are returned,
+// because the items processed before the failure are not a prefix of items.
+func ParallelMapTilError[T, R any](ctx context.Context, limit int, callback func(context.Context, T) (R, error), items ...T) ([]R, error) {
+	result := make([]R, len(items))
+
+	err := run(ctx, limit, len(items), func(ctx context.Context, i int) error {
+		r, err := callback(ctx, items[i])
+		if err != nil {
+			return err
+		}
+
+		result[i] = r
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ParallelFilter evaluates the predicate for each item using at most limit
+// goroutines and returns the items that satisfy it, in their original order.
+//
+// The returned error is non-nil only when ctx is canceled before every item
+// is evaluated.
+func ParallelFilter[T any](ctx context.Context, limit int, predicate func(context.Context, T) bool, items ...T) ([]T, error) {
+	keep := make([]bool, len(items))
+
+	err := run(ctx, limit, len(items), func(ctx context.Context, i int) error {
+		keep[i] = predicate(ctx, items[i])
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]T, 0)
+	for i := range items {
+		if keep[i] {
+			result = append(result, items[i])
+		}
+	}
+
+	return result, nil
+}
+
+// ParallelReduce splits items into at most limit contiguous chunks, reduces
+// each chunk concurrently with the callback, and folds the partial results
+// from left to right with combine.
+//
+// Every chunk starts from initialValue, so initialValue must be an identity
+// of combine (0 for a sum, "" for a concatenation, ...) and combine must be
+// associative for the result to match slice.Reduce. The cancellation of ctx
+// is checked between elements.
+func ParallelReduce[T, R any](ctx context.Context, limit int, initialValue R, callback func(R, T) R, combine func(R, R) R, items ...T) (R, error) {
+	if len(items) == 0 {
+		return initialValue, ctx.Err()
+	}
+
+	w := workers(limit, len(items))
+	chunks := slice.Divide((len(items)+w-1)/w, items...)
+	partials := make([]R, len(chunks))
+
+	err := run(ctx, w, len(chunks), func(ctx context.Context, i int) error {
+		acc := initialValue
+		for _, v := range chunks[i] {
+			if ctx.Err() != nil {
+				return errStopped
+			}
+
+			acc = callback(acc, v)
+		}
+
+		partials[i] = acc
+		return nil
+	})
+	if err != nil {
+		return initialValue, err
+	}
+
+	result := partials[0]
+	for _, p := range partials[1:] {
+		result = combine(result, p)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,200 @@
+package parallel
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cirius-go/generic/slice"
+)
+
+func seq(n int) []int {
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i
+	}
+
+	return items
+}
+
+func TestParallelMap(t *testing.T) {
+	items := seq(100)
+	double := func(i int) int { return i * 2 }
+
+	result, err := ParallelMap(context.Background(), 4, func(_ context.Context, i int) int {
+		return double(i)
+	}, items...)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	expected := slice.Map(double, items...)
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case: empty input
+	empty, err := ParallelMap(context.Background(), 4, func(_ context.Context, i int) int { return i })
+	if err != nil || len(empty) != 0 {
+		t.Errorf("Expected an empty result and no error, but got %v, %v", empty, err)
+	}
+}
+
+func TestParallelMapRespectsLimit(t *testing.T) {
+	var (
+		running atomic.Int64
+		peak    atomic.Int64
+	)
+
+	_, err := ParallelMap(context.Background(), 3, func(_ context.Context, i int) int {
+		cur := running.Add(1)
+		defer running.Add(-1)
+
+		for {
+			old := peak.Load()
+			if cur <= old || peak.CompareAndSwap(old, cur) {
+				break
+			}
+		}
+
+		time.Sleep(time.Millisecond)
+		return i
+	}, seq(30)...)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	if p := peak.Load(); p > 3 {
+		t.Errorf("Expected at most 3 concurrent callbacks, but got %d", p)
+	}
+}
+
+func TestParallelMapTilError(t *testing.T) {
+	errBoom := errors.New("boom")
+	var calls atomic.Int64
+
+	result, err := ParallelMapTilError(context.Background(), 2, func(ctx context.Context, i int) (int, error) {
+		calls.Add(1)
+		if i == 7 {
+			return 0, errBoom
+		}
+
+		return i, nil
+	}, seq(1000)...)
+
+	if result != nil {
+		t.Errorf("Expected nil result, but got %v", result)
+	}
+
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) {
+		t.Fatalf("Expected an *IndexError, but got %v", err)
+	}
+
+	if indexErr.Index != 7 || !errors.Is(err, errBoom) {
+		t.Errorf("Expected index 7 wrapping errBoom, but got %v", err)
+	}
+
+	if c := calls.Load(); c >= 1000 {
+		t.Errorf("Expected workers to stop early, but got %d calls", c)
+	}
+}
+
+func TestParallelMapTilErrorContextAware(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	for i := 0; i < 20; i++ {
+		// Index 0 waits for the context that the failure of index 1 cancels.
+		_, err := ParallelMapTilError(context.Background(), 2, func(ctx context.Context, i int) (int, error) {
+			if i == 1 {
+				return 0, errBoom
+			}
+
+			<-ctx.Done()
+			return 0, ctx.Err()
+		}, seq(2)...)
+
+		var indexErr *IndexError
+		if !errors.As(err, &indexErr) || indexErr.Index != 1 || !errors.Is(err, errBoom) {
+			t.Fatalf("Expected index 1 wrapping errBoom, but got %v", err)
+		}
+	}
+}
+
+func TestParallelMapCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := ParallelMap(ctx, 2, func(_ context.Context, i int) int { return i }, seq(10)...)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, but got %v", err)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	var calls atomic.Int64
+	_, err = ParallelMap(ctx, 2, func(_ context.Context, i int) int {
+		if calls.Add(1) == 5 {
+			cancel()
+		}
+
+		return i
+	}, seq(1000)...)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, but got %v", err)
+	}
+}
+
+func TestParallelFilter(t *testing.T) {
+	items := seq(50)
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	result, err := ParallelFilter(context.Background(), 8, func(_ context.Context, i int) bool {
+		return isEven(i)
+	}, items...)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	expected := slice.Filter(isEven, items...)
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestParallelReduce(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int
+		items []int
+	}{
+		{name: "More items than workers", limit: 4, items: seq(101)},
+		{name: "More workers than items", limit: 16, items: seq(3)},
+		{name: "Default limit", limit: 0, items: seq(10)},
+		{name: "Empty items", limit: 4, items: []int{}},
+	}
+
+	sum := func(acc, v int) int { return acc + v }
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParallelReduce(context.Background(), tt.limit, 0, sum, sum, tt.items...)
+			if err != nil {
+				t.Fatalf("Expected no error, but got %v", err)
+			}
+
+			if want := slice.Reduce(0, sum, tt.items...); got != want {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+
+	// Test case: order-sensitive but associative combine
+	words := []string{"a", "b", "c", "d", "e", "f", "g"}
+	concat := func(acc, v string) string { return acc + v }
+	got, err := ParallelReduce(context.Background(), 3, "", concat, concat, words...)
+	if err != nil || got != "abcdefg" {
+		t.Errorf("Expected abcdefg, but got %q (%v)", got, err)
+	}
+}
@@ -0,0 +1,241 @@
+package random
+
+import (
+	crand "crypto/rand"
+	"errors"
+	"iter"
+	"math"
+	"math/big"
+	"math/rand/v2"
+)
+
+var (
+	// ErrInvalidBound is returned when a random number is requested in an
+	// empty range.
+	ErrInvalidBound = errors.New("random: bound must be greater than 0")
+	// ErrInvalidWeights is returned by WeightedChoice when a weight is
+	// negative or not finite, or when no weight is positive.
+	ErrInvalidWeights = errors.New("random: invalid weights")
+)
+
+// Source produces uniformly distributed random integers. It is the only
+// thing the functions of this package, and the With variants of the slice
+// package, need from a random number generator.
+type Source interface {
+	// IntN returns a random integer in [0, n). It returns ErrInvalidBound
+	// if n is not positive.
+	IntN(n int) (int, error)
+}
+
+type cryptoSource struct{}
+
+// Crypto returns a Source backed by crypto/rand. It is safe for concurrent
+// use and suitable for security-sensitive choices, but much slower than the
+// other sources.
+func Crypto() Source {
+	return cryptoSource{}
+}
+
+func (cryptoSource) IntN(n int) (int, error) {
+	if n <= 0 {
+		return 0, ErrInvalidBound
+	}
+
+	v, err := crand.Int(crand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+
+	return int(v.Int64()), nil
+}
+
+type fastSource struct{}
+
+// Fast returns a Source backed by the randomly seeded global generator of
+// math/rand/v2. It is safe for concurrent use and never fails.
+func Fast() Source {
+	return fastSource{}
+}
+
+func (fastSource) IntN(n int) (int, error) {
+	if n <= 0 {
+		return 0, ErrInvalidBound
+	}
+
+	return rand.IntN(n), nil
+}
+
+type randSource struct {
+	r *rand.Rand
+}
+
+// FromRand returns a Source backed by r. It is safe for concurrent use only
+// if r is.
+func FromRand(r *rand.Rand) Source {
+	return randSource{r: r}
+}
+
+// Seeded returns a deterministic Source seeded with seed, which makes
+// shuffles and samples reproducible in tests. It is not safe for concurrent
+// use.
+func Seeded(seed uint64) Source {
+	return FromRand(rand.New(rand.NewPCG(seed, seed)))
+}
+
+func (s randSource) IntN(n int) (int, error) {
+	if n <= 0 {
+		return 0, ErrInvalidBound
+	}
+
+	return s.r.IntN(n), nil
+}
+
+// Float64 returns a random float64 in [0, 1) drawn from src.
+//
+// The 53 bits of precision are drawn in two parts, so that no bound passed
+// to IntN overflows a 32-bit int.
+func Float64(src Source) (float64, error) {
+	const (
+		lowBits   = 27
+		highBits  = 26
+		precision = 1 << (lowBits + highBits)
+	)
+
+	high, err := src.IntN(1 << highBits)
+	if err != nil {
+		return 0, err
+	}
+
+	low, err := src.IntN(1 << lowBits)
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(uint64(high)<<lowBits|uint64(low)) / precision, nil
+}
+
+// Shuffle shuffles items in place with the Fisher-Yates algorithm.
+func Shuffle[T any](src Source, items []T) error {
+	for i := len(items) - 1; i > 0; i-- {
+		j, err := src.IntN(i + 1)
+		if err != nil {
+			return err
+		}
+
+		items[i], items[j] = items[j], items[i]
+	}
+
+	return nil
+}
+
+// WeightedChoice returns an item picked with a probability proportional to
+// its weight. Items with a zero weight are never picked.
+func WeightedChoice[T any](src Source, weight func(T) float64, items ...T) (T, error) {
+	var zero T
+
+	weights := make([]float64, len(items))
+	total := 0.0
+
+	for i, v := range items {
+		w := weight(v)
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return zero, ErrInvalidWeights
+		}
+
+		weights[i] = w
+		total += w
+	}
+
+	if total <= 0 || math.IsInf(total, 0) {
+		return zero, ErrInvalidWeights
+	}
+
+	f, err := Float64(src)
+	if err != nil {
+		return zero, err
+	}
+
+	target := f * total
+	last := 0
+	for i, w := range weights {
+		if w == 0 {
+			continue
+		}
+
+		last = i
+		if target < w {
+			return items[i], nil
+		}
+
+		target -= w
+	}
+
+	// Rounding can leave a tiny remainder after the last positive weight.
+	return items[last], nil
+}
+
+// Reservoir returns k items picked uniformly from seq without knowing its
+// length in advance, using Algorithm R. It reads seq once and keeps only k
+// items in memory. The result has fewer than k items if seq is shorter.
+func Reservoir[T any](src Source, k int, seq iter.Seq[T]) ([]T, error) {
+	result := make([]T, 0, max(k, 0))
+	if k <= 0 {
+		return result, nil
+	}
+
+	n := 0
+	var err error
+	for v := range seq {
+		n++
+		if len(result) < k {
+			result = append(result, v)
+			continue
+		}
+
+		var j int
+		if j, err = src.IntN(n); err != nil {
+			break
+		}
+
+		if j < k {
+			result[j] = v
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Sample returns min(k, len(items)) distinct items in random order, without
+// modifying items. It runs a partial Fisher-Yates shuffle that records
+// swaps in a map, so it uses O(k) memory instead of copying items.
+func Sample[T any](src Source, k int, items ...T) ([]T, error) {
+	k = min(max(k, 0), len(items))
+	result := make([]T, k)
+
+	// swapped[i] is the index of the item virtually moved to position i.
+	swapped := make(map[int]int, k)
+	at := func(i int) int {
+		if j, ok := swapped[i]; ok {
+			return j
+		}
+
+		return i
+	}
+
+	for i := 0; i < k; i++ {
+		r, err := src.IntN(len(items) - i)
+		if err != nil {
+			return nil, err
+		}
+
+		j := i + r
+		result[i] = items[at(j)]
+		swapped[j] = at(i)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,180 @@
+package random
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestSources(t *testing.T) {
+	for name, src := range map[string]Source{
+		"crypto": Crypto(),
+		"fast":   Fast(),
+		"seeded": Seeded(1),
+	} {
+		t.Run(name, func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				if v, err := src.IntN(3); err != nil || v < 0 || v >= 3 {
+					t.Fatalf("IntN(3) = %v, %v", v, err)
+				}
+			}
+
+			if _, err := src.IntN(0); !errors.Is(err, ErrInvalidBound) {
+				t.Errorf("Expected ErrInvalidBound, but got %v", err)
+			}
+
+			if f, err := Float64(src); err != nil || f < 0 || f >= 1 {
+				t.Errorf("Float64() = %v, %v", f, err)
+			}
+		})
+	}
+}
+
+// maxSource returns the largest value allowed by every bound and records the
+// largest bound it was asked for.
+type maxSource struct {
+	bound int
+}
+
+func (s *maxSource) IntN(n int) (int, error) {
+	s.bound = max(s.bound, n)
+
+	return n - 1, nil
+}
+
+func TestFloat64Bounds(t *testing.T) {
+	src := &maxSource{}
+
+	f, err := Float64(src)
+	if err != nil || f >= 1 || f < 1-1e-15 {
+		t.Errorf("Expected the largest float below 1, but got %v, %v", f, err)
+	}
+
+	if src.bound > math.MaxInt32 {
+		t.Errorf("Expected bounds to fit in 32 bits, but got %d", src.bound)
+	}
+}
+
+func TestSeededIsReproducible(t *testing.T) {
+	a := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	b := slices.Clone(a)
+
+	Shuffle(Seeded(42), a)
+	Shuffle(Seeded(42), b)
+
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("Expected the same seed to give the same shuffle, but got %v and %v", a, b)
+	}
+
+	slices.Sort(a)
+	if !reflect.DeepEqual(a, []int{1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Errorf("Expected a permutation, but got %v", a)
+	}
+}
+
+func TestWeightedChoice(t *testing.T) {
+	src := Seeded(7)
+	weights := map[string]float64{"a": 1, "b": 3, "never": 0}
+	weight := func(s string) float64 { return weights[s] }
+
+	counts := make(map[string]int)
+	for i := 0; i < 4000; i++ {
+		v, err := WeightedChoice(src, weight, "a", "never", "b")
+		if err != nil {
+			t.Fatalf("WeightedChoice() error = %v", err)
+		}
+		counts[v]++
+	}
+
+	if counts["never"] != 0 {
+		t.Errorf("Expected a zero weight never to be picked, but got %d", counts["never"])
+	}
+
+	if ratio := float64(counts["b"]) / float64(counts["a"]); math.Abs(ratio-3) > 0.5 {
+		t.Errorf("Expected b to be picked about 3 times as often as a, but got %v", counts)
+	}
+
+	for _, w := range []float64{-1, math.NaN(), math.Inf(1)} {
+		if _, err := WeightedChoice(src, func(int) float64 { return w }, 1, 2); !errors.Is(err, ErrInvalidWeights) {
+			t.Errorf("Expected ErrInvalidWeights for weight %v, but got %v", w, err)
+		}
+	}
+
+	if _, err := WeightedChoice(src, weight, "never"); !errors.Is(err, ErrInvalidWeights) {
+		t.Errorf("Expected ErrInvalidWeights when every weight is zero, but got %v", err)
+	}
+}
+
+func seq(n int) func(func(int) bool) {
+	return func(yield func(int) bool) {
+		for i := 0; i < n; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestReservoir(t *testing.T) {
+	src := Seeded(3)
+
+	got, err := Reservoir(src, 5, seq(3))
+	if err != nil || !reflect.DeepEqual(got, []int{0, 1, 2}) {
+		t.Errorf("Reservoir() = %v, %v, want the whole short sequence", got, err)
+	}
+
+	counts := make([]int, 10)
+	for i := 0; i < 2000; i++ {
+		got, _ := Reservoir(src, 3, seq(10))
+		for _, v := range got {
+			counts[v]++
+		}
+	}
+
+	// Each element is picked with probability 3/10, so about 600 times.
+	for v, c := range counts {
+		if c < 480 || c > 720 {
+			t.Errorf("Element %d picked %d times, want about 600", v, c)
+		}
+	}
+}
+
+func TestSample(t *testing.T) {
+	src := Seeded(11)
+	items := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+
+	counts := make([]int, len(items))
+	for i := 0; i < 2000; i++ {
+		got, err := Sample(src, 4, items...)
+		if err != nil || len(got) != 4 {
+			t.Fatalf("Sample() = %v, %v", got, err)
+		}
+
+		seen := make(map[int]bool)
+		for _, v := range got {
+			if seen[v] {
+				t.Fatalf("Sample() = %v, want distinct items", got)
+			}
+			seen[v] = true
+			counts[v]++
+		}
+	}
+
+	for v, c := range counts {
+		if c < 650 || c > 950 {
+			t.Errorf("Element %d picked %d times, want about 800", v, c)
+		}
+	}
+
+	all, _ := Sample(src, 20, items...)
+	slices.Sort(all)
+	if !reflect.DeepEqual(all, items) {
+		t.Errorf("Expected a permutation of every item, but got %v", all)
+	}
+
+	if got, _ := Sample(src, -1, items...); len(got) != 0 {
+		t.Errorf("Sample(-1) = %v, want empty", got)
+	}
+}
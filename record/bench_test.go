@@ -0,0 +1,202 @@
+package record
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/cirius-go/generic/internal/benchtest"
+	"github.com/cirius-go/generic/tuple"
+)
+
+// benchSizes are the map sizes every benchmark case runs at.
+var benchSizes = []int{16, 256, 4096}
+
+// allocsSize is the map size of TestAllocs.
+const allocsSize = 256
+
+// benchInput holds the same n entries in every map flavour of the package.
+type benchInput struct {
+	m       map[int]int
+	ordered *OrderedMap[int, int]
+	sync    *SyncMap[int, int]
+	keys    []int
+	entries []tuple.Pair[int, int]
+	pairs   []tuple.Pair[int, int]
+	json    []byte
+}
+
+func newBenchInput(n int) *benchInput {
+	in := &benchInput{
+		m:       make(map[int]int, n),
+		ordered: NewOrderedMap[int, int](),
+		sync:    NewSyncMap[int, int](0),
+	}
+
+	for i := 0; i < n; i++ {
+		v := i % (n/4 + 1)
+
+		in.m[i] = v
+		in.ordered.Set(i, v)
+		in.sync.Store(i, v)
+		in.keys = append(in.keys, i)
+		in.entries = append(in.entries, tuple.NewPair(i, v))
+		in.pairs = append(in.pairs, tuple.NewPair(i, v))
+	}
+
+	in.json, _ = in.ordered.MarshalJSON()
+
+	return in
+}
+
+func (in *benchInput) lastKey() int {
+	return in.keys[len(in.keys)-1]
+}
+
+// benchCase exercises one exported function or method.
+type benchCase struct {
+	name string
+	fn   func(in *benchInput)
+	// allocs is the allocation budget per run at allocsSize; see
+	// benchtest.Case.
+	allocs float64
+}
+
+func benchCases() []benchCase {
+	sum := func(acc, k, v int) int { return acc + k + v }
+	pair := func(k, v int) int { return k * v }
+	even := func(k int) bool { return k%2 == 0 }
+
+	return []benchCase{
+		{"FindKeysByValue", func(in *benchInput) { FindKeysByValue(in.m, 1, 2, 3) }, 2},
+		{"Keys", func(in *benchInput) { Keys(in.m) }, 6},
+		{"Vals", func(in *benchInput) { Vals(in.m) }, 6},
+		{"ValByKeys", func(in *benchInput) { ValByKeys(in.m, in.keys[:10]...) }, 2},
+		{"ValsByKeyConds", func(in *benchInput) { ValsByKeyConds(in.m, even) }, 8},
+		{"Reduce", func(in *benchInput) { Reduce(0, sum, in.m) }, 0},
+		{"ReduceToSlice", func(in *benchInput) { ReduceToSlice(nil, pair, in.m) }, 6},
+		{"MapVals", func(in *benchInput) {
+			MapVals(in.m, func(_, v int) (int, error) { return v, nil })
+		}, 4},
+		{"Entries", func(in *benchInput) { Entries(in.m) }, 1},
+		{"FromEntries", func(in *benchInput) { FromEntries(in.pairs...) }, 3},
+		{"SortedKeys", func(in *benchInput) { SortedKeys(in.m) }, 9},
+		{"SortedKeysFunc", func(in *benchInput) { SortedKeysFunc(in.m, cmp.Compare[int]) }, 9},
+		{"SortedEntries", func(in *benchInput) { SortedEntries(in.m) }, 10},
+		{"SortedEntriesFunc", func(in *benchInput) { SortedEntriesFunc(in.m, cmp.Compare[int]) }, 10},
+		{"ReduceSorted", func(in *benchInput) { ReduceSorted(0, sum, in.m) }, 9},
+		{"ReduceSortedFunc", func(in *benchInput) { ReduceSortedFunc(0, sum, in.m, cmp.Compare[int]) }, 9},
+		{"ReduceToSliceSorted", func(in *benchInput) { ReduceToSliceSorted(nil, pair, in.m) }, 18},
+		{"ReduceToSliceSortedFunc", func(in *benchInput) {
+			ReduceToSliceSortedFunc(nil, pair, in.m, cmp.Compare[int])
+		}, 18},
+		{"NewOrderedMap", func(in *benchInput) { NewOrderedMap(in.entries...) }, 273},
+		{"OrderedFindKeysByValue", func(in *benchInput) { OrderedFindKeysByValue(in.ordered, 1, 2, 3) }, 5},
+		{"OrderedValByKeys", func(in *benchInput) { OrderedValByKeys(in.ordered, in.keys[:10]...) }, 2},
+		{"OrderedValsByKeyConds", func(in *benchInput) { OrderedValsByKeyConds(in.ordered, even) }, 8},
+		// The shard of each key depends on a random hash seed, so how often
+		// the shard maps grow varies from run to run: 95 to 120 allocations
+		// have been observed.
+		{"NewSyncMap", func(in *benchInput) {
+			m := NewSyncMap[int, int](0)
+			for _, k := range in.keys {
+				m.Store(k, k)
+			}
+		}, 150},
+		{"SyncKeys", func(in *benchInput) { SyncKeys(in.sync) }, 24},
+		{"SyncVals", func(in *benchInput) { SyncVals(in.sync) }, 24},
+		{"SyncFindKeysByValue", func(in *benchInput) { SyncFindKeysByValue(in.sync, 1, 2, 3) }, 20},
+		{"SyncReduce", func(in *benchInput) { SyncReduce(0, sum, in.sync) }, 15},
+		{"OrderedMap.Len", func(in *benchInput) { in.ordered.Len() }, 0},
+		{"OrderedMap.Get", func(in *benchInput) { in.ordered.Get(in.lastKey()) }, 0},
+		{"OrderedMap.Has", func(in *benchInput) { in.ordered.Has(-1) }, 0},
+		{"OrderedMap.Set", func(in *benchInput) { in.ordered.Set(in.lastKey(), 0) }, 0},
+		{"OrderedMap.Delete", func(in *benchInput) {
+			k := in.lastKey()
+			in.ordered.Delete(k)
+			in.ordered.Set(k, 0)
+		}, 1},
+		{"OrderedMap.MoveToFront", func(in *benchInput) {
+			in.ordered.MoveToFront(in.lastKey())
+			in.ordered.MoveToBack(in.lastKey())
+		}, 0},
+		{"OrderedMap.MoveToBack", func(in *benchInput) {
+			in.ordered.MoveToBack(in.keys[0])
+			in.ordered.MoveToFront(in.keys[0])
+		}, 0},
+		{"OrderedMap.All", func(in *benchInput) {
+			for range in.ordered.All() {
+			}
+		}, 0},
+		{"OrderedMap.Keys", func(in *benchInput) { in.ordered.Keys() }, 1},
+		{"OrderedMap.Vals", func(in *benchInput) { in.ordered.Vals() }, 1},
+		{"OrderedMap.Entries", func(in *benchInput) { in.ordered.Entries() }, 1},
+		{"OrderedMap.ToMap", func(in *benchInput) { in.ordered.ToMap() }, 4},
+		{"OrderedMap.Clone", func(in *benchInput) { in.ordered.Clone() }, 274},
+		{"OrderedMap.MarshalJSON", func(in *benchInput) { in.ordered.MarshalJSON() }, 1500},
+		{"OrderedMap.UnmarshalJSON", func(in *benchInput) { NewOrderedMap[int, int]().UnmarshalJSON(in.json) }, 1350},
+		{"SyncMap.Load", func(in *benchInput) { in.sync.Load(in.lastKey()) }, 0},
+		{"SyncMap.Store", func(in *benchInput) { in.sync.Store(in.lastKey(), 0) }, 0},
+		{"SyncMap.LoadOrStore", func(in *benchInput) { in.sync.LoadOrStore(in.lastKey(), 0) }, 0},
+		{"SyncMap.LoadAndDelete", func(in *benchInput) {
+			k := in.lastKey()
+			in.sync.LoadAndDelete(k)
+			in.sync.Store(k, 0)
+		}, 0},
+		{"SyncMap.Delete", func(in *benchInput) {
+			k := in.lastKey()
+			in.sync.Delete(k)
+			in.sync.Store(k, 0)
+		}, 0},
+		{"SyncMap.Compute", func(in *benchInput) {
+			in.sync.Compute(in.lastKey(), func(old int, _ bool) (int, bool) { return old, true })
+		}, 0},
+		{"SyncMap.ComputeIfAbsent", func(in *benchInput) {
+			in.sync.ComputeIfAbsent(in.lastKey(), func() int { return 0 })
+		}, 0},
+		{"SyncMap.Len", func(in *benchInput) { in.sync.Len() }, 0},
+		{"SyncMap.Range", func(in *benchInput) { in.sync.Range(func(int, int) bool { return true }) }, 32},
+		{"SyncMap.All", func(in *benchInput) {
+			for range in.sync.All() {
+			}
+		}, 32},
+		{"SyncMap.Snapshot", func(in *benchInput) { in.sync.Snapshot() }, 15},
+		// Clear is measured together with refilling the map, so that every
+		// run clears the same entries.
+		{"SyncMap.Clear", func(in *benchInput) {
+			in.sync.Clear()
+			for _, k := range in.keys {
+				in.sync.Store(k, in.m[k])
+			}
+		}, 0},
+	}
+}
+
+func suite() []benchtest.Case[func(in *benchInput)] {
+	result := make([]benchtest.Case[func(in *benchInput)], 0)
+	for _, bc := range benchCases() {
+		result = append(result, benchtest.Case[func(in *benchInput)]{Name: bc.name, Fn: bc.fn, Allocs: bc.allocs})
+	}
+
+	return result
+}
+
+// bindBench prepares the maps of n entries.
+func bindBench(n int) func(fn func(in *benchInput)) {
+	in := newBenchInput(n)
+
+	return func(fn func(in *benchInput)) { fn(in) }
+}
+
+func BenchmarkRecord(b *testing.B) {
+	benchtest.Benchmark(b, suite(), benchSizes, bindBench)
+}
+
+func TestAllocs(t *testing.T) {
+	benchtest.Allocs(t, suite(), allocsSize, bindBench)
+}
+
+// TestBenchCasesCoverage makes sure a new exported function or method does
+// not go without a benchmark case.
+func TestBenchCasesCoverage(t *testing.T) {
+	benchtest.Coverage(t, suite(), ".")
+}
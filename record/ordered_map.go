@@ -0,0 +1,410 @@
+package record
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"reflect"
+	"strconv"
+
+	"github.com/cirius-go/generic/tuple"
+)
+
+type orderedEntry[K comparable, V any] struct {
+	key        K
+	val        V
+	prev, next *orderedEntry[K, V]
+}
+
+// OrderedMap is a map that remembers the order in which keys were first
+// inserted.
+//
+// Updating the value of an existing key keeps its position; MoveToFront and
+// MoveToBack reorder keys explicitly. The zero value is an empty map ready to
+// use. Like a map, an OrderedMap that holds entries shares them with its
+// copies, so it can be embedded by value in a struct that is marshaled to
+// JSON. It is not safe for concurrent use.
+type OrderedMap[K comparable, V any] struct {
+	index map[K]*orderedEntry[K, V]
+
+	// root is a sentinel: root.next is the first entry and root.prev the
+	// last one, which keeps insertion and removal free of nil checks. It is
+	// allocated with index, so that copies share the same list.
+	root *orderedEntry[K, V]
+}
+
+// NewOrderedMap returns an ordered map holding the given key/value pairs in
+// order.
+func NewOrderedMap[K comparable, V any](entries ...tuple.Pair[K, V]) *OrderedMap[K, V] {
+	m := &OrderedMap[K, V]{}
+	for _, e := range entries {
+		m.Set(e.First, e.Second)
+	}
+
+	return m
+}
+
+func (m *OrderedMap[K, V]) lazyInit() {
+	if m.index == nil {
+		m.index = make(map[K]*orderedEntry[K, V])
+		m.root = &orderedEntry[K, V]{}
+		m.root.next = m.root
+		m.root.prev = m.root
+	}
+}
+
+func (m *OrderedMap[K, V]) unlink(e *orderedEntry[K, V]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.prev, e.next = nil, nil
+}
+
+func (m *OrderedMap[K, V]) insertAfter(e, at *orderedEntry[K, V]) {
+	e.prev = at
+	e.next = at.next
+	at.next.prev = e
+	at.next = e
+}
+
+// Len returns the number of entries.
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.index)
+}
+
+// Get returns the value stored for key and whether it was present.
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if e, ok := m.index[key]; ok {
+		return e.val, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Has reports whether key is present.
+func (m *OrderedMap[K, V]) Has(key K) bool {
+	_, ok := m.index[key]
+
+	return ok
+}
+
+// Set stores val for key. A new key is appended at the back; an existing key
+// keeps its position.
+func (m *OrderedMap[K, V]) Set(key K, val V) {
+	m.lazyInit()
+
+	if e, ok := m.index[key]; ok {
+		e.val = val
+		return
+	}
+
+	e := &orderedEntry[K, V]{key: key, val: val}
+	m.insertAfter(e, m.root.prev)
+	m.index[key] = e
+}
+
+// Delete removes key and reports whether it was present.
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	e, ok := m.index[key]
+	if !ok {
+		return false
+	}
+
+	m.unlink(e)
+	delete(m.index, key)
+
+	return true
+}
+
+// MoveToFront moves key to the front of the order and reports whether it was
+// present.
+func (m *OrderedMap[K, V]) MoveToFront(key K) bool {
+	e, ok := m.index[key]
+	if !ok {
+		return false
+	}
+
+	m.unlink(e)
+	m.insertAfter(e, m.root)
+
+	return true
+}
+
+// MoveToBack moves key to the back of the order and reports whether it was
+// present.
+func (m *OrderedMap[K, V]) MoveToBack(key K) bool {
+	e, ok := m.index[key]
+	if !ok {
+		return false
+	}
+
+	m.unlink(e)
+	m.insertAfter(e, m.root.prev)
+
+	return true
+}
+
+// All returns a sequence over the entries in order.
+//
+// Deleting the entry currently being visited is allowed; other mutations
+// during iteration have unspecified results.
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if m.index == nil {
+			return
+		}
+
+		for e := m.root.next; e != m.root; {
+			next := e.next
+			if !yield(e.key, e.val) {
+				return
+			}
+
+			e = next
+		}
+	}
+}
+
+// Keys returns all keys in order.
+func (m *OrderedMap[K, V]) Keys() []K {
+	result := make([]K, 0, m.Len())
+
+	for k := range m.All() {
+		result = append(result, k)
+	}
+
+	return result
+}
+
+// Vals returns all values in key order.
+func (m *OrderedMap[K, V]) Vals() []V {
+	result := make([]V, 0, m.Len())
+
+	for _, v := range m.All() {
+		result = append(result, v)
+	}
+
+	return result
+}
+
+// Entries returns all key/value pairs in order.
+func (m *OrderedMap[K, V]) Entries() []tuple.Pair[K, V] {
+	result := make([]tuple.Pair[K, V], 0, m.Len())
+
+	for k, v := range m.All() {
+		result = append(result, tuple.NewPair(k, v))
+	}
+
+	return result
+}
+
+// ToMap returns the entries as a plain, unordered map.
+func (m *OrderedMap[K, V]) ToMap() map[K]V {
+	result := make(map[K]V, m.Len())
+
+	for k, v := range m.All() {
+		result[k] = v
+	}
+
+	return result
+}
+
+// Clone returns a shallow copy of the map that keeps the same order.
+func (m *OrderedMap[K, V]) Clone() *OrderedMap[K, V] {
+	return NewOrderedMap(m.Entries()...)
+}
+
+// MarshalJSON encodes the map as a JSON object whose members follow the
+// order of the map.
+//
+// Keys are encoded with the same rules as encoding/json uses for map keys:
+// string kinds are used directly, encoding.TextMarshaler implementations are
+// marshaled, and integer kinds are formatted in base 10.
+//
+// It has a value receiver, so that maps held by value in other structs are
+// encoded too.
+func (m OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteByte('{')
+	first := true
+	for k, v := range m.All() {
+		key, err := encodeKey(k)
+		if err != nil {
+			return nil, err
+		}
+
+		val, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+
+		name, _ := json.Marshal(key)
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(val)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON replaces the contents of the map with the members of a JSON
+// object, keeping the order in which they appear. A JSON null empties the
+// map.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	*m = OrderedMap[K, V]{}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if tok == nil {
+		return nil
+	}
+
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("record: cannot unmarshal %v into OrderedMap", tok)
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		name, _ := tok.(string)
+		key, err := decodeKey[K](name)
+		if err != nil {
+			return err
+		}
+
+		var val V
+		if err := dec.Decode(&val); err != nil {
+			return err
+		}
+
+		m.Set(key, val)
+	}
+
+	_, err = dec.Token()
+
+	return err
+}
+
+func encodeKey[K comparable](k K) (string, error) {
+	rv := reflect.ValueOf(k)
+	if rv.Kind() == reflect.String {
+		return rv.String(), nil
+	}
+
+	if tm, ok := any(k).(encoding.TextMarshaler); ok {
+		text, err := tm.MarshalText()
+		return string(text), err
+	}
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	}
+
+	return "", fmt.Errorf("record: unsupported key type %T", k)
+}
+
+func decodeKey[K comparable](name string) (K, error) {
+	var k K
+
+	if tu, ok := any(&k).(encoding.TextUnmarshaler); ok {
+		err := tu.UnmarshalText([]byte(name))
+		return k, err
+	}
+
+	rv := reflect.ValueOf(&k).Elem()
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(name)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(name, 10, rv.Type().Bits())
+		if err != nil {
+			return k, fmt.Errorf("record: invalid key %q: %w", name, err)
+		}
+		rv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(name, 10, rv.Type().Bits())
+		if err != nil {
+			return k, fmt.Errorf("record: invalid key %q: %w", name, err)
+		}
+		rv.SetUint(n)
+	default:
+		return k, fmt.Errorf("record: unsupported key type %T", k)
+	}
+
+	return k, nil
+}
+
+// OrderedFindKeysByValue returns all keys of m that have one of the given
+// values, like FindKeysByValue but in insertion order, whatever the order of
+// the values.
+func OrderedFindKeysByValue[K, V comparable](m *OrderedMap[K, V], values ...V) []K {
+	wanted := make(map[V]struct{}, len(values))
+	for _, v := range values {
+		wanted[v] = struct{}{}
+	}
+
+	result := make([]K, 0)
+
+	for k, v := range m.All() {
+		if _, ok := wanted[v]; ok {
+			result = append(result, k)
+		}
+	}
+
+	return result
+}
+
+// OrderedValByKeys returns the values of the given keys that are present in
+// m, in the order the keys are given.
+func OrderedValByKeys[K comparable, V any](m *OrderedMap[K, V], keys ...K) []V {
+	result := make([]V, 0)
+
+	for _, k := range keys {
+		if v, ok := m.Get(k); ok {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// OrderedValsByKeyConds returns the values of m whose keys satisfy every
+// condition, like ValsByKeyConds but in insertion order.
+func OrderedValsByKeyConds[K comparable, V any](m *OrderedMap[K, V], keyConds ...func(K) bool) []V {
+	result := make([]V, 0)
+
+	for k, v := range m.All() {
+		valid := true
+		for _, cond := range keyConds {
+			if !cond(k) {
+				valid = false
+				break
+			}
+		}
+
+		if valid {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
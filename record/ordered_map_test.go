@@ -0,0 +1,165 @@
+package record
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cirius-go/generic/tuple"
+)
+
+func TestOrderedMapOrder(t *testing.T) {
+	var m OrderedMap[string, int]
+
+	m.Set("c", 3)
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 30)
+
+	if got := m.Keys(); !reflect.DeepEqual(got, []string{"c", "a", "b"}) {
+		t.Errorf("Keys() = %v, want %v", got, []string{"c", "a", "b"})
+	}
+
+	if got := m.Vals(); !reflect.DeepEqual(got, []int{30, 1, 2}) {
+		t.Errorf("Vals() = %v, want %v", got, []int{30, 1, 2})
+	}
+
+	if v, ok := m.Get("c"); !ok || v != 30 {
+		t.Errorf("Get(c) = %v, %v, want 30, true", v, ok)
+	}
+
+	m.MoveToBack("c")
+	m.MoveToFront("b")
+	if got := m.Keys(); !reflect.DeepEqual(got, []string{"b", "a", "c"}) {
+		t.Errorf("Keys() = %v, want %v", got, []string{"b", "a", "c"})
+	}
+
+	if !m.Delete("a") || m.Delete("a") || m.Has("a") {
+		t.Errorf("Expected a to be deleted exactly once")
+	}
+
+	expected := []tuple.Pair[string, int]{{First: "b", Second: 2}, {First: "c", Second: 30}}
+	if got := m.Entries(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Entries() = %v, want %v", got, expected)
+	}
+
+	if m.MoveToFront("missing") || m.MoveToBack("missing") {
+		t.Errorf("Expected moving a missing key to report false")
+	}
+}
+
+func TestOrderedMapDeleteWhileIterating(t *testing.T) {
+	m := NewOrderedMap(tuple.NewPair(1, 1), tuple.NewPair(2, 2), tuple.NewPair(3, 3))
+
+	for k, v := range m.All() {
+		if v%2 == 1 {
+			m.Delete(k)
+		}
+	}
+
+	if got := m.Keys(); !reflect.DeepEqual(got, []int{2}) {
+		t.Errorf("Keys() = %v, want %v", got, []int{2})
+	}
+}
+
+func TestOrderedMapJSON(t *testing.T) {
+	m := NewOrderedMap(
+		tuple.NewPair("zeta", 1),
+		tuple.NewPair("alpha", 2),
+		tuple.NewPair("mid\"dle", 3),
+	)
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	expected := `{"zeta":1,"alpha":2,"mid\"dle":3}`
+	if string(data) != expected {
+		t.Errorf("Marshal() = %s, want %s", data, expected)
+	}
+
+	var decoded OrderedMap[string, int]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(decoded.Entries(), m.Entries()) {
+		t.Errorf("Unmarshal() = %v, want %v", decoded.Entries(), m.Entries())
+	}
+
+	// Test case: integer keys and nested values
+	nested := NewOrderedMap(tuple.NewPair(10, []string{"x"}), tuple.NewPair[int, []string](2, nil))
+	data, err = json.Marshal(nested)
+	if err != nil || string(data) != `{"10":["x"],"2":null}` {
+		t.Errorf("Marshal() = %s, %v", data, err)
+	}
+
+	var ints OrderedMap[int, []string]
+	if err := json.Unmarshal(data, &ints); err != nil || !reflect.DeepEqual(ints.Keys(), []int{10, 2}) {
+		t.Errorf("Unmarshal() = %v, %v", ints.Keys(), err)
+	}
+
+	// Test case: invalid input
+	if err := json.Unmarshal([]byte(`{"x":1}`), &ints); err == nil || !strings.Contains(err.Error(), "invalid key") {
+		t.Errorf("Expected an invalid key error, but got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`null`), &decoded); err != nil || decoded.Len() != 0 {
+		t.Errorf("Expected null to empty the map, but got %v, %v", decoded.Keys(), err)
+	}
+
+	// Test case: a map held by value in a struct
+	type wrapper struct {
+		M OrderedMap[string, int]
+	}
+
+	var w wrapper
+	w.M.Set("b", 2)
+	w.M.Set("a", 1)
+
+	data, err = json.Marshal(w)
+	if err != nil || string(data) != `{"M":{"b":2,"a":1}}` {
+		t.Errorf("Marshal() = %s, %v", data, err)
+	}
+
+	var back wrapper
+	if err := json.Unmarshal(data, &back); err != nil || !reflect.DeepEqual(back.M.Keys(), []string{"b", "a"}) {
+		t.Errorf("Unmarshal() = %v, %v", back.M.Keys(), err)
+	}
+
+	// A copy shares the entries, like a map.
+	c := w.M
+	c.Delete("b")
+	if !reflect.DeepEqual(w.M.Keys(), []string{"a"}) {
+		t.Errorf("Expected the copy to share the entries, but got %v", w.M.Keys())
+	}
+}
+
+func TestOrderedHelpers(t *testing.T) {
+	m := NewOrderedMap(
+		tuple.NewPair("d", 1),
+		tuple.NewPair("b", 2),
+		tuple.NewPair("a", 1),
+		tuple.NewPair("c", 3),
+	)
+
+	if got := OrderedFindKeysByValue(m, 1, 3); !reflect.DeepEqual(got, []string{"d", "a", "c"}) {
+		t.Errorf("OrderedFindKeysByValue() = %v, want %v", got, []string{"d", "a", "c"})
+	}
+
+	if got := OrderedFindKeysByValue(m, 3, 2, 3); !reflect.DeepEqual(got, []string{"b", "c"}) {
+		t.Errorf("OrderedFindKeysByValue() = %v, want %v", got, []string{"b", "c"})
+	}
+
+	if got := OrderedValByKeys(m, "c", "missing", "d"); !reflect.DeepEqual(got, []int{3, 1}) {
+		t.Errorf("OrderedValByKeys() = %v, want %v", got, []int{3, 1})
+	}
+
+	notA := func(k string) bool { return k != "a" }
+	notB := func(k string) bool { return k != "b" }
+	if got := OrderedValsByKeyConds(m, notA, notB); !reflect.DeepEqual(got, []int{1, 3}) {
+		t.Errorf("OrderedValsByKeyConds() = %v, want %v", got, []int{1, 3})
+	}
+}
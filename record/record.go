@@ -1,5 +1,12 @@
 package record
 
+import (
+	"errors"
+
+	"github.com/cirius-go/generic/result"
+	"github.com/cirius-go/generic/tuple"
+)
+
 // FindKeysByValue returns all keys that have a given value
 func FindKeysByValue[K, V comparable](m map[K]V, values ...V) []K {
 	result := make([]K, 0)
@@ -84,3 +91,49 @@ func ReduceToSlice[R any, K comparable, V any](init []R, fn func(K, V) R, m map[
 		return append(res, fn(k, v))
 	}, m)
 }
+
+// MapVals maps every entry of m with fn into a new map.
+//
+// It does not stop at the first failure: every error is wrapped in a
+// *result.KeyError carrying its key, and all of them are joined with
+// errors.Join. Failed entries are left out of the returned map.
+func MapVals[K comparable, V, R any](m map[K]V, fn func(K, V) (R, error)) (map[K]R, error) {
+	mapped := make(map[K]R, len(m))
+	errs := make([]error, 0)
+
+	for k, v := range m {
+		r, err := fn(k, v)
+		if err != nil {
+			errs = append(errs, result.WrapKey(k, err))
+			continue
+		}
+
+		mapped[k] = r
+	}
+
+	return mapped, errors.Join(errs...)
+}
+
+// Entries returns the key/value pairs of m in unspecified order. Use
+// SortedEntries for a deterministic order.
+func Entries[K comparable, V any](m map[K]V) []tuple.Pair[K, V] {
+	result := make([]tuple.Pair[K, V], 0, len(m))
+
+	for k, v := range m {
+		result = append(result, tuple.NewPair(k, v))
+	}
+
+	return result
+}
+
+// FromEntries builds a map from key/value pairs. When a key appears more
+// than once the last pair wins. It is the inverse of Entries.
+func FromEntries[K comparable, V any](entries ...tuple.Pair[K, V]) map[K]V {
+	result := make(map[K]V, len(entries))
+
+	for _, e := range entries {
+		result[e.First] = e.Second
+	}
+
+	return result
+}
@@ -0,0 +1,46 @@
+package record
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/cirius-go/generic/result"
+	"github.com/cirius-go/generic/tuple"
+)
+
+func TestMapVals(t *testing.T) {
+	m := map[string]string{"a": "1", "b": "x", "c": "3"}
+
+	mapped, err := MapVals(m, func(_ string, v string) (int, error) {
+		return strconv.Atoi(v)
+	})
+
+	if !reflect.DeepEqual(mapped, map[string]int{"a": 1, "c": 3}) {
+		t.Errorf("MapVals() = %v, want %v", mapped, map[string]int{"a": 1, "c": 3})
+	}
+
+	var keyErr *result.KeyError[string]
+	if !errors.As(err, &keyErr) || keyErr.Key != "b" || !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("Expected a key b error wrapping strconv.ErrSyntax, but got %v", err)
+	}
+}
+
+func TestEntriesRoundTrip(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	entries := Entries(m)
+	if len(entries) != 3 {
+		t.Errorf("Expected 3 entries, but got %v", entries)
+	}
+
+	if got := FromEntries(entries...); !reflect.DeepEqual(got, m) {
+		t.Errorf("Expected %v, but got %v", m, got)
+	}
+
+	got := FromEntries(tuple.NewPair("a", 1), tuple.NewPair("a", 2))
+	if !reflect.DeepEqual(got, map[string]int{"a": 2}) {
+		t.Errorf("Expected the last pair to win, but got %v", got)
+	}
+}
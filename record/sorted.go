@@ -0,0 +1,74 @@
+package record
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/cirius-go/generic/tuple"
+)
+
+// SortedKeys returns all keys of m in ascending order.
+func SortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	keys := Keys(m)
+	slices.Sort(keys)
+
+	return keys
+}
+
+// SortedKeysFunc returns all keys of m ordered by the comparator, which
+// returns a negative number when a < b, zero when a == b and a positive
+// number when a > b.
+func SortedKeysFunc[K comparable, V any](m map[K]V, compare func(a, b K) int) []K {
+	keys := Keys(m)
+	slices.SortFunc(keys, compare)
+
+	return keys
+}
+
+// SortedEntries returns all key/value pairs of m in ascending key order.
+func SortedEntries[K cmp.Ordered, V any](m map[K]V) []tuple.Pair[K, V] {
+	return SortedEntriesFunc(m, cmp.Compare[K])
+}
+
+// SortedEntriesFunc returns all key/value pairs of m ordered by the
+// comparator applied to their keys.
+func SortedEntriesFunc[K comparable, V any](m map[K]V, compare func(a, b K) int) []tuple.Pair[K, V] {
+	keys := SortedKeysFunc(m, compare)
+	result := make([]tuple.Pair[K, V], 0, len(keys))
+
+	for _, k := range keys {
+		result = append(result, tuple.NewPair(k, m[k]))
+	}
+
+	return result
+}
+
+// ReduceSorted is like Reduce, but visits the entries in ascending key
+// order so order-dependent reductions are reproducible.
+func ReduceSorted[R any, K cmp.Ordered, V any](init R, fn func(R, K, V) R, m map[K]V) R {
+	return ReduceSortedFunc(init, fn, m, cmp.Compare[K])
+}
+
+// ReduceSortedFunc is like Reduce, but visits the entries in the key order
+// defined by the comparator.
+func ReduceSortedFunc[R any, K comparable, V any](init R, fn func(R, K, V) R, m map[K]V, compare func(a, b K) int) R {
+	for _, k := range SortedKeysFunc(m, compare) {
+		init = fn(init, k, m[k])
+	}
+
+	return init
+}
+
+// ReduceToSliceSorted is like ReduceToSlice, but appends the mapped entries
+// in ascending key order.
+func ReduceToSliceSorted[R any, K cmp.Ordered, V any](init []R, fn func(K, V) R, m map[K]V) []R {
+	return ReduceToSliceSortedFunc(init, fn, m, cmp.Compare[K])
+}
+
+// ReduceToSliceSortedFunc is like ReduceToSlice, but appends the mapped
+// entries in the key order defined by the comparator.
+func ReduceToSliceSortedFunc[R any, K comparable, V any](init []R, fn func(K, V) R, m map[K]V, compare func(a, b K) int) []R {
+	return ReduceSortedFunc(init, func(res []R, k K, v V) []R {
+		return append(res, fn(k, v))
+	}, m, compare)
+}
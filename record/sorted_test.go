@@ -0,0 +1,77 @@
+package record
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cirius-go/generic/tuple"
+)
+
+func TestSortedKeys(t *testing.T) {
+	m := map[string]int{"b": 2, "c": 3, "a": 1}
+
+	if got := SortedKeys(m); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("SortedKeys() = %v, want %v", got, []string{"a", "b", "c"})
+	}
+
+	desc := func(a, b string) int { return strings.Compare(b, a) }
+	if got := SortedKeysFunc(m, desc); !reflect.DeepEqual(got, []string{"c", "b", "a"}) {
+		t.Errorf("SortedKeysFunc() = %v, want %v", got, []string{"c", "b", "a"})
+	}
+
+	expected := []tuple.Pair[string, int]{{First: "a", Second: 1}, {First: "b", Second: 2}, {First: "c", Second: 3}}
+	if got := SortedEntries(m); !reflect.DeepEqual(got, expected) {
+		t.Errorf("SortedEntries() = %v, want %v", got, expected)
+	}
+
+	if got := FromEntries(SortedEntries(m)...); !reflect.DeepEqual(got, m) {
+		t.Errorf("FromEntries(SortedEntries()) = %v, want %v", got, m)
+	}
+
+	if got := NewOrderedMap(SortedEntries(m)...).ToMap(); !reflect.DeepEqual(got, m) {
+		t.Errorf("NewOrderedMap(SortedEntries()) = %v, want %v", got, m)
+	}
+
+	if got := SortedKeys(map[int]bool{}); len(got) != 0 {
+		t.Errorf("SortedKeys() = %v, want an empty slice", got)
+	}
+}
+
+func TestSortedKeysFuncNonOrdered(t *testing.T) {
+	type point struct{ X, Y int }
+	m := map[point]string{{2, 1}: "c", {1, 5}: "b", {1, 2}: "a"}
+
+	byXY := func(a, b point) int {
+		if a.X != b.X {
+			return a.X - b.X
+		}
+
+		return a.Y - b.Y
+	}
+
+	got := ReduceToSliceSortedFunc(nil, func(_ point, v string) string { return v }, m, byXY)
+	if !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("ReduceToSliceSortedFunc() = %v, want %v", got, []string{"a", "b", "c"})
+	}
+}
+
+func TestReduceSorted(t *testing.T) {
+	m := map[int]string{3: "c", 1: "a", 2: "b"}
+
+	// Repeat to make sure the randomized map order does not leak through.
+	for i := 0; i < 10; i++ {
+		got := ReduceSorted("", func(acc string, k int, v string) string {
+			return acc + fmt.Sprintf("%d=%s;", k, v)
+		}, m)
+		if got != "1=a;2=b;3=c;" {
+			t.Fatalf("ReduceSorted() = %q, want %q", got, "1=a;2=b;3=c;")
+		}
+
+		pairs := ReduceToSliceSorted([]string{}, func(k int, v string) string { return v }, m)
+		if !reflect.DeepEqual(pairs, []string{"a", "b", "c"}) {
+			t.Fatalf("ReduceToSliceSorted() = %v, want %v", pairs, []string{"a", "b", "c"})
+		}
+	}
+}
@@ -0,0 +1,365 @@
+package record
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"iter"
+	"math"
+	"reflect"
+	"sync"
+
+	"github.com/cirius-go/generic/tuple"
+)
+
+// defaultShards is the number of shards used when none is requested.
+const defaultShards = 32
+
+type syncShard[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  map[K]V
+}
+
+// SyncMap is a typed map that is safe for concurrent use.
+//
+// Keys are spread over independently locked shards, so goroutines touching
+// different keys rarely contend. The zero value is ready to use with the
+// default number of shards; use NewSyncMap to pick another one.
+type SyncMap[K comparable, V any] struct {
+	once   sync.Once
+	seed   maphash.Seed
+	shards []syncShard[K, V]
+}
+
+// NewSyncMap returns a SyncMap with the given number of shards, rounded up
+// to a power of two. A non-positive count uses the default.
+func NewSyncMap[K comparable, V any](shards int) *SyncMap[K, V] {
+	m := &SyncMap[K, V]{}
+	m.once.Do(func() { m.init(shards) })
+
+	return m
+}
+
+func (m *SyncMap[K, V]) init(shards int) {
+	if shards <= 0 {
+		shards = defaultShards
+	}
+
+	n := 1
+	for n < shards {
+		n <<= 1
+	}
+
+	m.seed = maphash.MakeSeed()
+	m.shards = make([]syncShard[K, V], n)
+	for i := range m.shards {
+		m.shards[i].m = make(map[K]V)
+	}
+}
+
+func (m *SyncMap[K, V]) shard(key K) *syncShard[K, V] {
+	m.once.Do(func() { m.init(defaultShards) })
+
+	h := hashKey(m.seed, key)
+	return &m.shards[h&uint64(len(m.shards)-1)]
+}
+
+// hashKey hashes a comparable key so that equal keys get equal hashes.
+// Strings and integers take a fast path; other keys are hashed through
+// reflection.
+func hashKey[K comparable](seed maphash.Seed, key K) uint64 {
+	switch k := any(key).(type) {
+	case string:
+		return maphash.String(seed, k)
+	case int:
+		return hashUint(seed, uint64(k))
+	case int64:
+		return hashUint(seed, uint64(k))
+	case uint64:
+		return hashUint(seed, k)
+	}
+
+	return hashAny(seed, key)
+}
+
+// hashAny hashes keys without a fast path. It is kept out of hashKey so that
+// only those keys are moved to the heap.
+func hashAny(seed maphash.Seed, key any) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	writeValue(&h, reflect.ValueOf(key))
+
+	return h.Sum64()
+}
+
+func hashUint(seed maphash.Seed, n uint64) uint64 {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], n)
+
+	return maphash.Bytes(seed, buf[:])
+}
+
+// writeValue writes v to h following the rules of ==: -0 and +0 hash alike,
+// and pointers, channels and interfaces hash by identity and dynamic value.
+func writeValue(h *maphash.Hash, v reflect.Value) {
+	var buf [8]byte
+
+	writeUint := func(n uint64) {
+		binary.LittleEndian.PutUint64(buf[:], n)
+		h.Write(buf[:])
+	}
+
+	writeFloat := func(f float64) {
+		if f == 0 {
+			f = 0
+		}
+
+		writeUint(math.Float64bits(f))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		h.WriteString(v.String())
+	case reflect.Bool:
+		if v.Bool() {
+			h.WriteByte(1)
+		} else {
+			h.WriteByte(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeFloat(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		writeFloat(real(v.Complex()))
+		writeFloat(imag(v.Complex()))
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		writeUint(uint64(v.Pointer()))
+	case reflect.Interface:
+		if v.IsNil() {
+			h.WriteByte(0)
+		} else {
+			writeValue(h, v.Elem())
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			writeValue(h, v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			writeValue(h, v.Field(i))
+		}
+	}
+}
+
+// Load returns the value stored for key and whether it was present.
+func (m *SyncMap[K, V]) Load(key K) (V, bool) {
+	s := m.shard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	v, ok := s.m[key]
+	return v, ok
+}
+
+// Store sets the value for key.
+func (m *SyncMap[K, V]) Store(key K, val V) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.m[key] = val
+}
+
+// LoadOrStore returns the existing value for key if present. Otherwise it
+// stores val and returns it. loaded reports whether the value was already
+// present.
+func (m *SyncMap[K, V]) LoadOrStore(key K, val V) (actual V, loaded bool) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if v, ok := s.m[key]; ok {
+		return v, true
+	}
+
+	s.m[key] = val
+	return val, false
+}
+
+// LoadAndDelete deletes key and returns its previous value, if any.
+func (m *SyncMap[K, V]) LoadAndDelete(key K) (V, bool) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.m[key]
+	delete(s.m, key)
+
+	return v, ok
+}
+
+// Delete removes key.
+func (m *SyncMap[K, V]) Delete(key K) {
+	m.LoadAndDelete(key)
+}
+
+// Compute atomically replaces the value of key with the result of fn.
+//
+// fn receives the current value and whether it was present. It returns the
+// new value and whether to keep it; returning keep == false deletes the key.
+// Compute returns the resulting value and whether the key is present
+// afterwards. fn runs while the shard of key is locked, so it must be fast
+// and must not call back into the map.
+func (m *SyncMap[K, V]) Compute(key K, fn func(old V, loaded bool) (val V, keep bool)) (V, bool) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old, loaded := s.m[key]
+	val, keep := fn(old, loaded)
+	if !keep {
+		delete(s.m, key)
+
+		var zero V
+		return zero, false
+	}
+
+	s.m[key] = val
+	return val, true
+}
+
+// ComputeIfAbsent returns the value of key, computing and storing it with fn
+// first if it is absent. computed reports whether fn was called. fn runs
+// while the shard of key is locked, so it is called at most once per key
+// even under contention.
+func (m *SyncMap[K, V]) ComputeIfAbsent(key K, fn func() V) (val V, computed bool) {
+	s := m.shard(key)
+
+	s.mu.RLock()
+	v, ok := s.m[key]
+	s.mu.RUnlock()
+	if ok {
+		return v, false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if v, ok := s.m[key]; ok {
+		return v, false
+	}
+
+	v = fn()
+	s.m[key] = v
+	return v, true
+}
+
+// Len returns the number of entries. Under concurrent writes the result is
+// only a hint; use Snapshot for a consistent view.
+func (m *SyncMap[K, V]) Len() int {
+	m.once.Do(func() { m.init(defaultShards) })
+
+	n := 0
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.mu.RLock()
+		n += len(s.m)
+		s.mu.RUnlock()
+	}
+
+	return n
+}
+
+// Range calls fn for each entry until fn returns false.
+//
+// Like sync.Map.Range, it does not reflect a single point in time: entries
+// stored or deleted concurrently may or may not be visited. Each shard is
+// copied before fn is called, so fn may safely call back into the map.
+func (m *SyncMap[K, V]) Range(fn func(key K, val V) bool) {
+	for k, v := range m.All() {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// All returns a sequence over the entries with the same guarantees as Range.
+func (m *SyncMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.once.Do(func() { m.init(defaultShards) })
+
+		for i := range m.shards {
+			s := &m.shards[i]
+			s.mu.RLock()
+			entries := make([]tuple.Pair[K, V], 0, len(s.m))
+			for k, v := range s.m {
+				entries = append(entries, tuple.NewPair(k, v))
+			}
+			s.mu.RUnlock()
+
+			for _, e := range entries {
+				if !yield(e.First, e.Second) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Snapshot returns a copy of the map taken at a single point in time: every
+// shard is locked while the copy is made.
+func (m *SyncMap[K, V]) Snapshot() map[K]V {
+	m.once.Do(func() { m.init(defaultShards) })
+
+	for i := range m.shards {
+		m.shards[i].mu.RLock()
+	}
+
+	result := make(map[K]V)
+	for i := range m.shards {
+		for k, v := range m.shards[i].m {
+			result[k] = v
+		}
+	}
+
+	for i := range m.shards {
+		m.shards[i].mu.RUnlock()
+	}
+
+	return result
+}
+
+// Clear removes every entry.
+func (m *SyncMap[K, V]) Clear() {
+	m.once.Do(func() { m.init(defaultShards) })
+
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.mu.Lock()
+		clear(s.m)
+		s.mu.Unlock()
+	}
+}
+
+// SyncKeys returns all keys of a consistent snapshot of m.
+func SyncKeys[K comparable, V any](m *SyncMap[K, V]) []K {
+	return Keys(m.Snapshot())
+}
+
+// SyncVals returns all values of a consistent snapshot of m.
+func SyncVals[K comparable, V any](m *SyncMap[K, V]) []V {
+	return Vals(m.Snapshot())
+}
+
+// SyncFindKeysByValue returns all keys of a consistent snapshot of m that
+// have one of the given values.
+func SyncFindKeysByValue[K, V comparable](m *SyncMap[K, V], values ...V) []K {
+	return FindKeysByValue(m.Snapshot(), values...)
+}
+
+// SyncReduce reduces a consistent snapshot of m.
+func SyncReduce[R any, K comparable, V any](init R, fn func(R, K, V) R, m *SyncMap[K, V]) R {
+	return Reduce(init, fn, m.Snapshot())
+}
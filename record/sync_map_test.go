@@ -0,0 +1,175 @@
+package record
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestSyncMapBasics(t *testing.T) {
+	var m SyncMap[string, int]
+
+	m.Store("a", 1)
+	if v, ok := m.Load("a"); !ok || v != 1 {
+		t.Errorf("Load(a) = %v, %v, want 1, true", v, ok)
+	}
+
+	if v, loaded := m.LoadOrStore("a", 2); !loaded || v != 1 {
+		t.Errorf("LoadOrStore(a) = %v, %v, want 1, true", v, loaded)
+	}
+
+	if v, loaded := m.LoadOrStore("b", 2); loaded || v != 2 {
+		t.Errorf("LoadOrStore(b) = %v, %v, want 2, false", v, loaded)
+	}
+
+	if v, ok := m.LoadAndDelete("b"); !ok || v != 2 {
+		t.Errorf("LoadAndDelete(b) = %v, %v, want 2, true", v, ok)
+	}
+
+	if v, ok := m.Compute("a", func(old int, loaded bool) (int, bool) { return old + 10, true }); !ok || v != 11 {
+		t.Errorf("Compute(a) = %v, %v, want 11, true", v, ok)
+	}
+
+	if _, ok := m.Compute("a", func(int, bool) (int, bool) { return 0, false }); ok || m.Len() != 0 {
+		t.Errorf("Expected Compute returning keep == false to delete a")
+	}
+
+	if v, computed := m.ComputeIfAbsent("c", func() int { return 3 }); !computed || v != 3 {
+		t.Errorf("ComputeIfAbsent(c) = %v, %v, want 3, true", v, computed)
+	}
+
+	if v, computed := m.ComputeIfAbsent("c", func() int { return 4 }); computed || v != 3 {
+		t.Errorf("ComputeIfAbsent(c) = %v, %v, want 3, false", v, computed)
+	}
+
+	m.Clear()
+	if m.Len() != 0 {
+		t.Errorf("Len() = %d after Clear, want 0", m.Len())
+	}
+}
+
+func TestSyncMapKeys(t *testing.T) {
+	type point struct {
+		X, Y float64
+		Tag  any
+	}
+
+	m := NewSyncMap[point, int](64)
+	negZero := math.Copysign(0, -1)
+
+	m.Store(point{X: 0, Y: 1, Tag: "a"}, 1)
+	if v, ok := m.Load(point{X: negZero, Y: 1, Tag: "a"}); !ok || v != 1 {
+		t.Errorf("Expected -0 and +0 to find the same key, but got %d, %t", v, ok)
+	}
+
+	if _, ok := m.Load(point{X: 0, Y: 1, Tag: 1}); ok {
+		t.Errorf("Expected a different dynamic value to miss")
+	}
+
+	ids := NewSyncMap[any, string](64)
+	ids.Store(nil, "nil")
+	ids.Store(7, "int")
+	if v, _ := ids.Load(nil); v != "nil" {
+		t.Errorf("Expected %q, but got %q", "nil", v)
+	}
+
+	if v, _ := ids.Load(7); v != "int" {
+		t.Errorf("Expected %q, but got %q", "int", v)
+	}
+}
+
+func TestSyncMapHelpers(t *testing.T) {
+	m := NewSyncMap[string, int](3)
+	for i, k := range []string{"a", "b", "c", "d"} {
+		m.Store(k, i%2)
+	}
+
+	keys := SyncKeys(m)
+	sort.Strings(keys)
+	if !reflect.DeepEqual(keys, []string{"a", "b", "c", "d"}) {
+		t.Errorf("SyncKeys() = %v", keys)
+	}
+
+	found := SyncFindKeysByValue(m, 1)
+	sort.Strings(found)
+	if !reflect.DeepEqual(found, []string{"b", "d"}) {
+		t.Errorf("SyncFindKeysByValue() = %v", found)
+	}
+
+	if got := len(SyncVals(m)); got != 4 {
+		t.Errorf("len(SyncVals()) = %d, want 4", got)
+	}
+
+	sum := SyncReduce(0, func(acc int, _ string, v int) int { return acc + v }, m)
+	if sum != 2 {
+		t.Errorf("SyncReduce() = %d, want 2", sum)
+	}
+
+	visited := 0
+	m.Range(func(string, int) bool {
+		visited++
+		return visited < 2
+	})
+	if visited != 2 {
+		t.Errorf("Expected Range to stop after 2 entries, but visited %d", visited)
+	}
+}
+
+func TestSyncMapConcurrent(t *testing.T) {
+	m := NewSyncMap[int, int](0)
+
+	const (
+		goroutines = 16
+		keys       = 64
+		rounds     = 500
+	)
+
+	var (
+		wg    sync.WaitGroup
+		calls atomic.Int64
+	)
+
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+
+			for i := 0; i < rounds; i++ {
+				k := (g + i) % keys
+				m.Compute(k, func(old int, _ bool) (int, bool) { return old + 1, true })
+				m.ComputeIfAbsent(keys+k, func() int {
+					calls.Add(1)
+					return k
+				})
+				m.Load(k)
+
+				if i%100 == 0 {
+					_ = m.Snapshot()
+					m.Range(func(k, v int) bool {
+						m.Load(k)
+						return true
+					})
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	total := 0
+	for k, v := range m.Snapshot() {
+		if k < keys {
+			total += v
+		}
+	}
+
+	if total != goroutines*rounds {
+		t.Errorf("Expected %d increments, but got %d", goroutines*rounds, total)
+	}
+
+	if c := calls.Load(); c != keys {
+		t.Errorf("Expected ComputeIfAbsent to compute each key once, but got %d calls", c)
+	}
+}
@@ -0,0 +1,183 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Result holds either a value (Ok) or an error (Err).
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok returns a successful Result holding v.
+func Ok[T any](v T) Result[T] {
+	return Result[T]{value: v}
+}
+
+// Err returns a failed Result holding err. It panics if err is nil, because
+// a nil error would silently turn the Result into an Ok zero value.
+func Err[T any](err error) Result[T] {
+	if err == nil {
+		panic("result: Err called with a nil error")
+	}
+
+	return Result[T]{err: err}
+}
+
+// Of converts the (value, error) convention into a Result.
+func Of[T any](v T, err error) Result[T] {
+	if err != nil {
+		return Result[T]{err: err}
+	}
+
+	return Ok(v)
+}
+
+// IsOk reports whether the Result holds a value.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr reports whether the Result holds an error.
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Err returns the error, or nil for an Ok Result.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Get returns the value and the error in the (value, error) convention.
+func (r Result[T]) Get() (T, error) {
+	return r.value, r.err
+}
+
+// Unwrap returns the value and panics with the error if the Result is Err.
+// It is the Result counterpart of generic.Must.
+func (r Result[T]) Unwrap() T {
+	if r.err != nil {
+		panic(r.err)
+	}
+
+	return r.value
+}
+
+// UnwrapOr returns the value, or def if the Result is Err.
+func (r Result[T]) UnwrapOr(def T) T {
+	if r.err != nil {
+		return def
+	}
+
+	return r.value
+}
+
+// UnwrapOrElse returns the value, or the result of fn applied to the error
+// if the Result is Err.
+func (r Result[T]) UnwrapOrElse(fn func(error) T) T {
+	if r.err != nil {
+		return fn(r.err)
+	}
+
+	return r.value
+}
+
+// String implements fmt.Stringer.
+func (r Result[T]) String() string {
+	if r.err != nil {
+		return fmt.Sprintf("Err(%v)", r.err)
+	}
+
+	return fmt.Sprintf("Ok(%v)", r.value)
+}
+
+// Map applies callback to the value of r, if any. An Err is passed through.
+func Map[T, R any](r Result[T], callback func(T) R) Result[R] {
+	if r.err != nil {
+		return Result[R]{err: r.err}
+	}
+
+	return Ok(callback(r.value))
+}
+
+// AndThen applies callback to the value of r, if any, and returns its
+// result. It chains operations that may each fail.
+func AndThen[T, R any](r Result[T], callback func(T) Result[R]) Result[R] {
+	if r.err != nil {
+		return Result[R]{err: r.err}
+	}
+
+	return callback(r.value)
+}
+
+// Collect gathers the values of every Ok result, in order, and joins the
+// errors of every Err result with errors.Join instead of stopping at the
+// first one. Each error is annotated with the index of its result.
+func Collect[T any](results ...Result[T]) ([]T, error) {
+	values := make([]T, 0, len(results))
+	errs := make([]error, 0)
+
+	for i, r := range results {
+		if r.err != nil {
+			errs = append(errs, WrapIndex(i, r.err))
+			continue
+		}
+
+		values = append(values, r.value)
+	}
+
+	return values, errors.Join(errs...)
+}
+
+// IndexError annotates an error with the index of the element that caused
+// it.
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+// Error implements the error interface.
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error so errors.Is and errors.As keep working.
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// WrapIndex annotates err with index. It returns nil if err is nil.
+func WrapIndex(index int, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &IndexError{Index: index, Err: err}
+}
+
+// KeyError annotates an error with the map key of the entry that caused it.
+type KeyError[K comparable] struct {
+	Key K
+	Err error
+}
+
+// Error implements the error interface.
+func (e *KeyError[K]) Error() string {
+	return fmt.Sprintf("key %v: %v", e.Key, e.Err)
+}
+
+// Unwrap returns the underlying error so errors.Is and errors.As keep working.
+func (e *KeyError[K]) Unwrap() error {
+	return e.Err
+}
+
+// WrapKey annotates err with key. It returns nil if err is nil.
+func WrapKey[K comparable](key K, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &KeyError[K]{Key: key, Err: err}
+}
@@ -0,0 +1,116 @@
+package result
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+var errBoom = errors.New("boom")
+
+func TestOkErr(t *testing.T) {
+	ok := Ok(1)
+	if !ok.IsOk() || ok.IsErr() || ok.Unwrap() != 1 || ok.Err() != nil {
+		t.Errorf("Expected Ok(1), but got %v", ok)
+	}
+
+	bad := Err[int](errBoom)
+	if bad.IsOk() || !bad.IsErr() || !errors.Is(bad.Err(), errBoom) {
+		t.Errorf("Expected Err(boom), but got %v", bad)
+	}
+
+	if got := bad.UnwrapOr(5); got != 5 {
+		t.Errorf("UnwrapOr() = %v, want 5", got)
+	}
+
+	if got := bad.UnwrapOrElse(func(err error) int { return len(err.Error()) }); got != 4 {
+		t.Errorf("UnwrapOrElse() = %v, want 4", got)
+	}
+
+	if v, err := Of(strconv.Atoi("12")).Get(); v != 12 || err != nil {
+		t.Errorf("Of() = %v, %v, want 12, nil", v, err)
+	}
+
+	if r := Of(strconv.Atoi("x")); r.IsOk() {
+		t.Errorf("Of() = %v, want an Err", r)
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r != errBoom {
+				t.Errorf("Expected Unwrap to panic with the error, but got %v", r)
+			}
+		}()
+		bad.Unwrap()
+	}()
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected Err(nil) to panic")
+		}
+	}()
+	Err[int](nil)
+}
+
+func TestMapAndThen(t *testing.T) {
+	parse := func(s string) Result[int] { return Of(strconv.Atoi(s)) }
+
+	if got := Map(Ok(2), func(i int) string { return strconv.Itoa(i * 2) }); got.Unwrap() != "4" {
+		t.Errorf("Map() = %v, want Ok(4)", got)
+	}
+
+	if got := Map(Err[int](errBoom), strconv.Itoa); !errors.Is(got.Err(), errBoom) {
+		t.Errorf("Map() = %v, want Err(boom)", got)
+	}
+
+	if got := AndThen(Ok("7"), parse); got.Unwrap() != 7 {
+		t.Errorf("AndThen() = %v, want Ok(7)", got)
+	}
+
+	if got := AndThen(Ok("x"), parse); got.IsOk() {
+		t.Errorf("AndThen() = %v, want an Err", got)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	errOther := errors.New("other")
+
+	values, err := Collect(Ok(1), Err[int](errBoom), Ok(3), Err[int](errOther))
+	if len(values) != 2 || values[0] != 1 || values[1] != 3 {
+		t.Errorf("Collect() values = %v, want [1 3]", values)
+	}
+
+	if !errors.Is(err, errBoom) || !errors.Is(err, errOther) {
+		t.Fatalf("Collect() error = %v, want both errors joined", err)
+	}
+
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) || indexErr.Index != 1 {
+		t.Errorf("Expected the first error to be annotated with index 1, but got %v", err)
+	}
+
+	if err.Error() != "index 1: boom\nindex 3: other" {
+		t.Errorf("Collect() error = %q", err.Error())
+	}
+
+	words, err := Collect(Ok("a"))
+	if err != nil || len(words) != 1 {
+		t.Errorf("Collect() = %v, %v, want [a], nil", words, err)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	if WrapIndex(1, nil) != nil || WrapKey("k", nil) != nil {
+		t.Errorf("Expected wrapping a nil error to return nil")
+	}
+
+	err := WrapKey("user-1", errBoom)
+	var keyErr *KeyError[string]
+	if !errors.As(err, &keyErr) || keyErr.Key != "user-1" || !errors.Is(err, errBoom) {
+		t.Errorf("WrapKey() = %v", err)
+	}
+
+	if err.Error() != "key user-1: boom" {
+		t.Errorf("WrapKey() message = %q", err.Error())
+	}
+}
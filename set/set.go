@@ -0,0 +1,217 @@
+package set
+
+import "iter"
+
+// Set is an unordered collection of unique comparable values.
+//
+// The zero value is a nil map: it can be read from but Add panics on it, so
+// sets should be created with New or one of the From* constructors.
+type Set[T comparable] map[T]struct{}
+
+// New returns a set containing the given items.
+func New[T comparable](items ...T) Set[T] {
+	s := make(Set[T], len(items))
+	s.Add(items...)
+
+	return s
+}
+
+// FromKeys returns a set containing every key of m.
+func FromKeys[K comparable, V any](m map[K]V) Set[K] {
+	s := make(Set[K], len(m))
+	for k := range m {
+		s[k] = struct{}{}
+	}
+
+	return s
+}
+
+// FromVals returns a set containing every distinct value of m.
+func FromVals[K, V comparable](m map[K]V) Set[V] {
+	s := make(Set[V], len(m))
+	for _, v := range m {
+		s[v] = struct{}{}
+	}
+
+	return s
+}
+
+// FromSeq returns a set containing every value yielded by seq.
+func FromSeq[T comparable](seq iter.Seq[T]) Set[T] {
+	s := make(Set[T])
+	for v := range seq {
+		s[v] = struct{}{}
+	}
+
+	return s
+}
+
+// Add inserts the given items into the set.
+func (s Set[T]) Add(items ...T) {
+	for i := range items {
+		s[items[i]] = struct{}{}
+	}
+}
+
+// Remove deletes the given items from the set. Missing items are ignored.
+func (s Set[T]) Remove(items ...T) {
+	for i := range items {
+		delete(s, items[i])
+	}
+}
+
+// Has reports whether item is in the set.
+func (s Set[T]) Has(item T) bool {
+	_, ok := s[item]
+
+	return ok
+}
+
+// HasAll reports whether every given item is in the set.
+func (s Set[T]) HasAll(items ...T) bool {
+	for i := range items {
+		if !s.Has(items[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HasAny reports whether at least one of the given items is in the set.
+func (s Set[T]) HasAny(items ...T) bool {
+	for i := range items {
+		if s.Has(items[i]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Len returns the number of items in the set.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Clone returns a shallow copy of the set.
+func (s Set[T]) Clone() Set[T] {
+	result := make(Set[T], len(s))
+	for v := range s {
+		result[v] = struct{}{}
+	}
+
+	return result
+}
+
+// Union returns a new set with the items that are in s or in any of others.
+func (s Set[T]) Union(others ...Set[T]) Set[T] {
+	result := s.Clone()
+	for _, other := range others {
+		for v := range other {
+			result[v] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// Intersect returns a new set with the items that are in both s and other.
+func (s Set[T]) Intersect(other Set[T]) Set[T] {
+	small, large := s, other
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+
+	result := make(Set[T])
+	for v := range small {
+		if large.Has(v) {
+			result[v] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// Difference returns a new set with the items of s that are not in other.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	result := make(Set[T])
+	for v := range s {
+		if !other.Has(v) {
+			result[v] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// SymmetricDifference returns a new set with the items that are in exactly
+// one of s and other.
+func (s Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+	result := s.Difference(other)
+	for v := range other {
+		if !s.Has(v) {
+			result[v] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// IsSubset reports whether every item of s is also in other.
+func (s Set[T]) IsSubset(other Set[T]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+
+	for v := range s {
+		if !other.Has(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSuperset reports whether every item of other is also in s.
+func (s Set[T]) IsSuperset(other Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Equal reports whether s and other contain exactly the same items.
+func (s Set[T]) Equal(other Set[T]) bool {
+	return len(s) == len(other) && s.IsSubset(other)
+}
+
+// All returns a sequence over the items of the set in unspecified order.
+func (s Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns the items of the set in unspecified order.
+//
+// The result is assignable to slice.C[T].
+func (s Set[T]) ToSlice() []T {
+	result := make([]T, 0, len(s))
+	for v := range s {
+		result = append(result, v)
+	}
+
+	return result
+}
+
+// ToMap returns a map from every item of s to the value computed by valFn.
+func ToMap[T comparable, V any](s Set[T], valFn func(T) V) map[T]V {
+	result := make(map[T]V, len(s))
+	for v := range s {
+		result[v] = valFn(v)
+	}
+
+	return result
+}
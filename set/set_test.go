@@ -0,0 +1,121 @@
+package set
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func sorted(s Set[int]) []int {
+	result := s.ToSlice()
+	sort.Ints(result)
+
+	return result
+}
+
+func TestAddRemoveHas(t *testing.T) {
+	s := New(1, 2, 2, 3)
+	if s.Len() != 3 {
+		t.Errorf("Expected 3 items, but got %d", s.Len())
+	}
+
+	s.Add(4, 5)
+	s.Remove(1, 42)
+	if !reflect.DeepEqual(sorted(s), []int{2, 3, 4, 5}) {
+		t.Errorf("Expected %v, but got %v", []int{2, 3, 4, 5}, sorted(s))
+	}
+
+	if s.Has(1) || !s.Has(4) {
+		t.Errorf("Expected Has(1) = false and Has(4) = true")
+	}
+
+	if !s.HasAll(2, 3) || s.HasAll(2, 9) {
+		t.Errorf("Unexpected HasAll result on %v", sorted(s))
+	}
+
+	if !s.HasAny(9, 5) || s.HasAny(9, 10) {
+		t.Errorf("Unexpected HasAny result on %v", sorted(s))
+	}
+
+	// A nil set can be read from.
+	var empty Set[int]
+	if empty.Has(1) || empty.Len() != 0 || !empty.HasAll() {
+		t.Errorf("Expected a nil set to behave as empty")
+	}
+}
+
+func TestAlgebra(t *testing.T) {
+	a := New(1, 2, 3, 4)
+	b := New(3, 4, 5)
+
+	tests := []struct {
+		name string
+		got  Set[int]
+		want []int
+	}{
+		{name: "Union", got: a.Union(b), want: []int{1, 2, 3, 4, 5}},
+		{name: "Union of many", got: a.Union(b, New(9)), want: []int{1, 2, 3, 4, 5, 9}},
+		{name: "Intersect", got: a.Intersect(b), want: []int{3, 4}},
+		{name: "Difference", got: a.Difference(b), want: []int{1, 2}},
+		{name: "SymmetricDifference", got: a.SymmetricDifference(b), want: []int{1, 2, 5}},
+		{name: "Intersect disjoint", got: a.Intersect(New(7)), want: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sorted(tt.got); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// The operands must stay untouched.
+	if !reflect.DeepEqual(sorted(a), []int{1, 2, 3, 4}) || !reflect.DeepEqual(sorted(b), []int{3, 4, 5}) {
+		t.Errorf("Expected operands to be unchanged, but got %v and %v", sorted(a), sorted(b))
+	}
+}
+
+func TestSubsetSuperset(t *testing.T) {
+	a := New(1, 2)
+	b := New(1, 2, 3)
+
+	if !a.IsSubset(b) || b.IsSubset(a) {
+		t.Errorf("Unexpected IsSubset result")
+	}
+
+	if !b.IsSuperset(a) || a.IsSuperset(b) {
+		t.Errorf("Unexpected IsSuperset result")
+	}
+
+	if !a.Equal(New(2, 1)) || a.Equal(b) {
+		t.Errorf("Unexpected Equal result")
+	}
+
+	if !New[int]().IsSubset(a) {
+		t.Errorf("Expected the empty set to be a subset of every set")
+	}
+}
+
+func TestConversions(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 1}
+
+	keys := FromKeys(m).ToSlice()
+	sort.Strings(keys)
+	if !reflect.DeepEqual(keys, []string{"a", "b", "c"}) {
+		t.Errorf("Expected %v, but got %v", []string{"a", "b", "c"}, keys)
+	}
+
+	if got := sorted(FromVals(m)); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("Expected %v, but got %v", []int{1, 2}, got)
+	}
+
+	squares := ToMap(New(2, 3), func(v int) int { return v * v })
+	if !reflect.DeepEqual(squares, map[int]int{2: 4, 3: 9}) {
+		t.Errorf("Expected %v, but got %v", map[int]int{2: 4, 3: 9}, squares)
+	}
+
+	fromSeq := FromSeq(New(1, 2, 3).All())
+	if !fromSeq.Equal(New(1, 2, 3)) {
+		t.Errorf("Expected %v, but got %v", []int{1, 2, 3}, sorted(fromSeq))
+	}
+}
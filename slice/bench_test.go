@@ -0,0 +1,240 @@
+package slice
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cirius-go/generic/internal/benchtest"
+)
+
+// benchSizes are the input lengths every benchmark case runs at. They are
+// far enough apart for a quadratic helper to stand out.
+var benchSizes = []int{16, 256, 4096}
+
+// allocsSize is the input length of TestAllocs.
+const allocsSize = 256
+
+type benchMerge int
+
+func (m benchMerge) Merge(next benchMerge) benchMerge {
+	return max(m, next)
+}
+
+// benchCase exercises one exported function or method. fn receives the
+// input and a scratch buffer of the same length, which mutating functions
+// copy the input into so that every run sees the same data.
+type benchCase struct {
+	name string
+	fn   func(items, buf []int)
+	// allocs is the allocation budget per run at allocsSize; see
+	// benchtest.Case.
+	allocs float64
+}
+
+var errBench = errors.New("bench")
+
+func isEven(v int) bool { return v%2 == 0 }
+
+func double(v int) int { return v * 2 }
+
+func sub(a, b int) int { return a - b }
+
+func benchCases() []benchCase {
+	never := func(v int) bool { return v < 0 }
+	last := func(v int) bool { return v == allocsSize/4 }
+	lessEq := func(a, b int) bool { return a == b }
+	key := func(v int) int { return v % 10 }
+	sum := func(acc, v int) int { return acc + v }
+
+	return []benchCase{
+		{"Concat", func(items, _ []int) { Concat(items, items) }, 2},
+		{"ConcatUnique", func(items, _ []int) { ConcatUnique(items, items) }, 10},
+		{"RemoveDuplicates", func(items, _ []int) { RemoveDuplicates(items...) }, 8},
+		{"UniqueElem", func(items, _ []int) { UniqueElem(lessEq, items...) }, 1},
+		{"Unshift", func(items, _ []int) { Unshift(0, items...) }, 1},
+		{"IUnshift", func(items, _ []int) { IUnshift(items, 0) }, 1},
+		{"Reverse", func(items, _ []int) { Reverse(items...) }, 6},
+		{"FindIndex", func(items, _ []int) { FindIndex(never, items...) }, 0},
+		{"IFindIndex", func(items, _ []int) { IFindIndex(items, never) }, 0},
+		{"Find", func(items, _ []int) { Find(last, items...) }, 0},
+		{"FindOpt", func(items, _ []int) { FindOpt(last, items...) }, 0},
+		{"FindOrDefault", func(items, _ []int) { FindOrDefault(never, items...) }, 0},
+		{"IFind", func(items, _ []int) { IFind(items, never) }, 0},
+		{"IFindOrDefault", func(items, _ []int) { IFindOrDefault(items, never) }, 0},
+		{"Filter", func(items, _ []int) { Filter(isEven, items...) }, 6},
+		{"IFilter", func(items, _ []int) { IFilter(items, isEven) }, 6},
+		{"FilterAndSeparate", func(items, _ []int) { FilterAndSeparate(isEven, items...) }, 11},
+		{"IFilterAndSeparate", func(items, _ []int) { IFilterAndSeparate(items, isEven) }, 17},
+		{"Every", func(items, _ []int) { Every(func(v int) bool { return v >= 0 }, items...) }, 0},
+		{"IEvery", func(items, _ []int) { IEvery(items, func(v int) bool { return v >= 0 }) }, 0},
+		{"Some", func(items, _ []int) { Some(never, items...) }, 0},
+		{"ISome", func(items, _ []int) { ISome(items, never) }, 0},
+		{"Map", func(items, _ []int) { Map(double, items...) }, 6},
+		{"IMap", func(items, _ []int) { IMap(items, double) }, 6},
+		{"At", func(items, _ []int) { At(-1, items...) }, 0},
+		{"AtOpt", func(items, _ []int) { AtOpt(-1, items...) }, 0},
+		{"IAt", func(items, _ []int) { IAt(items, -1) }, 0},
+		{"Includes", func(items, _ []int) { Includes(-1, items...) }, 0},
+		{"IIncludes", func(items, _ []int) { IIncludes(items, -1) }, 0},
+		{"Pop", func(items, _ []int) { Pop(items...) }, 0},
+		{"Shift", func(items, _ []int) { Shift(items...) }, 0},
+		{"Reduce", func(items, _ []int) { Reduce(0, sum, items...) }, 0},
+		{"IReduce", func(items, _ []int) { IReduce(items, 0, sum) }, 0},
+		{"ReduceWithError", func(items, _ []int) {
+			ReduceWithError(0, func(acc, v int) (int, error) { return acc + v, nil }, items...)
+		}, 0},
+		{"IReduceWithError", func(items, _ []int) {
+			IReduceWithError(items, 0, func(acc, v int) (int, error) { return acc + v, nil })
+		}, 0},
+		{"Clone", func(items, _ []int) { Clone(items) }, 1},
+		{"NonZero", func(items, _ []int) { NonZero(items...) }, 6},
+		{"FisrtNonZero", func(items, _ []int) { FisrtNonZero(items...) }, 0},
+		{"FirstNonZeroOpt", func(items, _ []int) { FirstNonZeroOpt(items...) }, 0},
+		{"FirstOrDefault", func(items, _ []int) { FirstOrDefault(-1, items...) }, 0},
+		{"FirstOrDefaultArr", func(items, _ []int) { FirstOrDefaultArr(nil, nil, items) }, 0},
+		{"MapTilError", func(items, _ []int) {
+			MapTilError(func(v int) (int, error) { return v, nil }, items...)
+		}, 6},
+		{"IMapTilError", func(items, _ []int) {
+			IMapTilError(items, func(v int) (int, error) { return v, nil })
+		}, 9},
+		{"MapResult", func(items, _ []int) {
+			MapResult(func(v int) (int, error) { return v, nil }, items...)
+		}, 1},
+		{"MapCollect", func(items, _ []int) {
+			MapCollect(func(v int) (int, error) {
+				if v%64 == 0 {
+					return 0, errBench
+				}
+				return v, nil
+			}, items...)
+		}, 13},
+		{"MapSkip", func(items, _ []int) {
+			MapSkip(func(v int) (int, bool) { return v, isEven(v) }, items...)
+		}, 5},
+		{"IMapSkip", func(items, _ []int) {
+			IMapSkip(items, func(v int) (int, bool) { return v, isEven(v) })
+		}, 5},
+		{"ToAnys", func(items, _ []int) { ToAnys(items...) }, 7},
+		{"ExcludeIfIn", func(items, _ []int) { ExcludeIfIn(items, items[len(items)/2:]...) }, 3},
+		{"ExcludeIfNotIn", func(items, _ []int) { ExcludeIfNotIn(items, items[len(items)/2:]...) }, 12},
+		{"Sort", func(items, buf []int) {
+			copy(buf, items)
+			Sort(func(i, j int) bool { return buf[i] > buf[j] }, buf...)
+		}, 2},
+		{"ArrContains", func(items, _ []int) { ArrContains(items, items[len(items)/2:]) }, 3},
+		{"ContainsAll", func(items, _ []int) { ContainsAll(items, items[len(items)/2:]...) }, 3},
+		{"MergeFn", func(items, _ []int) { MergeFn(benchMerge(items[0]), benchMerge(items[1])) }, 0},
+		{"ReduceMergeFn", func(items, _ []int) {
+			ReduceMergeFn(benchMerge(0), Map(func(v int) benchMerge { return benchMerge(v) }, items...)...)
+		}, 6},
+		{"GetRandomArray", func(items, _ []int) { GetRandomArray(items, len(items)/2) }, -1},
+		{"GetRandomArrayWith", func(items, _ []int) { GetRandomArrayWith(benchSource{}, items, len(items)/2) }, 4},
+		{"Shuffle", func(items, _ []int) { Shuffle(items) }, -1},
+		{"CryptoRandInt", func(items, _ []int) { CryptoRandInt(len(items)) }, -1},
+		{"RandIntWith", func(items, _ []int) { RandIntWith(benchSource{}, len(items)) }, 0},
+		{"ShuffleWith", func(items, _ []int) { ShuffleWith(benchSource{}, items) }, 1},
+		{"Loop", func(items, _ []int) { Loop(func(int, int) {}, items...) }, 0},
+		{"ILoop", func(items, _ []int) { ILoop(items, func(int, int) {}) }, 0},
+		{"Pipe", func(items, _ []int) { Pipe(items[0], double, double) }, 0},
+		{"SPipe", func(items, _ []int) { SPipe(items, double, double) }, 6},
+		{"Divide", func(items, _ []int) { Divide(10, items...) }, 1},
+		{"ExcludeByIndex", func(items, _ []int) { ExcludeByIndex(items, []int{1, 5, 9}) }, 6},
+		{"Intersection", func(items, _ []int) { Intersection(items, items[len(items)/2:]) }, 8},
+		{"GroupBy", func(items, _ []int) { GroupBy(key, items...) }, 63},
+		{"GroupByOrdered", func(items, _ []int) { GroupByOrdered(key, items...) }, 77},
+		{"KeyBy", func(items, _ []int) { KeyBy(key, KeepLast, items...) }, 4},
+		{"KeyByOrdered", func(items, _ []int) { KeyByOrdered(key, KeepFirst, items...) }, 17},
+		{"Associate", func(items, _ []int) { Associate(key, double, KeepLast, items...) }, 4},
+		{"AssociateOrdered", func(items, _ []int) { AssociateOrdered(key, double, KeepLast, items...) }, 17},
+		{"CountBy", func(items, _ []int) { CountBy(key, items...) }, 3},
+		{"UniqueBy", func(items, _ []int) { UniqueBy(key, KeepFirst, items...) }, 8},
+		{"DuplicatesBy", func(items, _ []int) { DuplicatesBy(key, items...) }, 63},
+		{"CountDuplicates", func(items, _ []int) { CountDuplicates(key, items...) }, 3},
+		{"PartitionN", func(items, _ []int) {
+			PartitionN([]func(int) bool{isEven, func(v int) bool { return v%3 == 0 }}, items...)
+		}, 25},
+		{"FilterInPlace", func(items, buf []int) { copy(buf, items); FilterInPlace(isEven, buf) }, 0},
+		{"RetainFunc", func(items, buf []int) {
+			copy(buf, items)
+			RetainFunc(func(i, _ int) bool { return isEven(i) }, buf)
+		}, 0},
+		{"ReverseInPlace", func(_, buf []int) { ReverseInPlace(buf) }, 0},
+		{"CompactInPlace", func(items, buf []int) { copy(buf, items); CompactInPlace(buf) }, 3},
+		{"DeleteIndices", func(items, buf []int) { copy(buf, items); DeleteIndices(buf, 1, 5, 9) }, 0},
+		{"SortFunc", func(items, buf []int) { copy(buf, items); SortFunc(sub, buf) }, 0},
+		{"SortStable", func(items, buf []int) { copy(buf, items); SortStable(sub, buf) }, 0},
+		{"SortBy", func(items, buf []int) { copy(buf, items); SortBy(key, buf) }, 0},
+		{"Sorted", func(items, _ []int) { Sorted(sub, items...) }, 1},
+		{"SortedBy", func(items, _ []int) { SortedBy(key, items...) }, 1},
+		{"PartialSort", func(items, buf []int) { copy(buf, items); PartialSort(10, sub, buf) }, 0},
+		{"TopK", func(items, _ []int) { TopK(10, sub, items...) }, 5},
+		{"E.Concat", func(items, _ []int) { E[int](items).Concat(items) }, 2},
+		{"E.Unshift", func(items, _ []int) { E[int](items).Unshift(0) }, 1},
+		{"E.Reverse", func(items, _ []int) { E[int](items).Reverse() }, 6},
+		{"E.FindIndex", func(items, _ []int) { E[int](items).FindIndex(never) }, 0},
+		{"E.Find", func(items, _ []int) { E[int](items).Find(last) }, 0},
+		{"E.Filter", func(items, _ []int) { E[int](items).Filter(isEven, items...) }, 6},
+		{"E.Every", func(items, _ []int) { E[int](items).Every(isEven, items...) }, 0},
+		{"E.Some", func(items, _ []int) { E[int](items).Some(never, items...) }, 0},
+		{"E.At", func(items, _ []int) { E[int](items).At(-1) }, 0},
+		{"E.FindOpt", func(items, _ []int) { E[int](items).FindOpt(last) }, 0},
+		{"E.AtOpt", func(items, _ []int) { E[int](items).AtOpt(-1) }, 0},
+		{"E.Deque", func(items, _ []int) { E[int](items).Deque() }, 2},
+		{"C.Concat", func(items, _ []int) { C[int](items).Concat(items) }, 2},
+		{"C.RemoveDuplicates", func(items, _ []int) { C[int](items).RemoveDuplicates() }, 8},
+		{"C.ConcatUnique", func(items, _ []int) { C[int](items).ConcatUnique(items) }, 10},
+		{"C.Unshift", func(items, _ []int) { C[int](items).Unshift(0) }, 1},
+		{"C.Reverse", func(items, _ []int) { C[int](items).Reverse() }, 6},
+		{"C.FindIndex", func(items, _ []int) { C[int](items).FindIndex(never) }, 0},
+		{"C.Find", func(items, _ []int) { C[int](items).Find(last) }, 0},
+		{"C.Filter", func(items, _ []int) { C[int](items).Filter(isEven, items...) }, 6},
+		{"C.Every", func(items, _ []int) { C[int](items).Every(isEven, items...) }, 0},
+		{"C.Some", func(items, _ []int) { C[int](items).Some(never, items...) }, 0},
+		{"C.At", func(items, _ []int) { C[int](items).At(-1) }, 0},
+		{"C.FindOpt", func(items, _ []int) { C[int](items).FindOpt(last) }, 0},
+		{"C.AtOpt", func(items, _ []int) { C[int](items).AtOpt(-1) }, 0},
+		{"C.ToSet", func(items, _ []int) { C[int](items).ToSet() }, 4},
+		{"C.Deque", func(items, _ []int) { C[int](items).Deque() }, 2},
+	}
+}
+
+// benchSource is a cheap deterministic random.Source, so the With variants
+// can be measured without the cost of crypto/rand.
+type benchSource struct{}
+
+func (benchSource) IntN(n int) (int, error) {
+	return n / 2, nil
+}
+
+func suite() []benchtest.Case[func(items, buf []int)] {
+	result := make([]benchtest.Case[func(items, buf []int)], 0)
+	for _, bc := range benchCases() {
+		result = append(result, benchtest.Case[func(items, buf []int)]{Name: bc.name, Fn: bc.fn, Allocs: bc.allocs})
+	}
+
+	return result
+}
+
+// bindBench prepares n input items and a scratch buffer holding a copy.
+func bindBench(n int) func(fn func(items, buf []int)) {
+	items := benchInput(n)
+	buf := make([]int, n)
+	copy(buf, items)
+
+	return func(fn func(items, buf []int)) { fn(items, buf) }
+}
+
+func BenchmarkSlice(b *testing.B) {
+	benchtest.Benchmark(b, suite(), benchSizes, bindBench)
+}
+
+func TestAllocs(t *testing.T) {
+	benchtest.Allocs(t, suite(), allocsSize, bindBench)
+}
+
+// TestBenchCasesCoverage makes sure a new exported function or method does
+// not go without a benchmark case.
+func TestBenchCasesCoverage(t *testing.T) {
+	benchtest.Coverage(t, suite(), ".")
+}
@@ -1,5 +1,11 @@
 package slice
 
+import (
+	"github.com/cirius-go/generic/deque"
+	"github.com/cirius-go/generic/option"
+	"github.com/cirius-go/generic/set"
+)
+
 type C[T comparable] []T
 
 func (c C[T]) Concat(slices ...[]T) C[T] {
@@ -46,3 +52,19 @@ func (c C[T]) Some(predicate func(T) bool, items ...T) bool {
 func (c C[T]) At(index int) (T, bool) {
 	return At[T](index, c...)
 }
+
+func (c C[T]) FindOpt(f func(T) bool) option.Option[T] {
+	return FindOpt[T](f, c...)
+}
+
+func (c C[T]) AtOpt(index int) option.Option[T] {
+	return AtOpt[T](index, c...)
+}
+
+func (c C[T]) ToSet() set.Set[T] {
+	return set.New[T](c...)
+}
+
+func (c C[T]) Deque() *deque.Deque[T] {
+	return deque.New[T](c...)
+}
@@ -1,5 +1,10 @@
 package slice
 
+import (
+	"github.com/cirius-go/generic/deque"
+	"github.com/cirius-go/generic/option"
+)
+
 type E[T any] []T
 
 func (e E[T]) Concat(slices ...[]T) E[T] {
@@ -38,3 +43,15 @@ func (e E[T]) Some(predicate func(T) bool, items ...T) bool {
 func (e E[T]) At(index int) (T, bool) {
 	return At[T](index, e...)
 }
+
+func (e E[T]) FindOpt(f func(T) bool) option.Option[T] {
+	return FindOpt[T](f, e...)
+}
+
+func (e E[T]) AtOpt(index int) option.Option[T] {
+	return AtOpt[T](index, e...)
+}
+
+func (e E[T]) Deque() *deque.Deque[T] {
+	return deque.New[T](e...)
+}
@@ -0,0 +1,90 @@
+package slice
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+)
+
+func FuzzDivide(f *testing.F) {
+	f.Add([]byte("abcdefg"), 3)
+	f.Add([]byte{}, 1)
+	f.Add([]byte("ab"), 5)
+
+	f.Fuzz(func(t *testing.T, data []byte, to int) {
+		if to < 1 || to > 1<<16 {
+			t.Skip()
+		}
+
+		chunks := Divide(to, data...)
+		if want := (len(data) + to - 1) / to; len(chunks) != want {
+			t.Fatalf("Divide(%d) returned %d chunks for %d items, want %d", to, len(chunks), len(data), want)
+		}
+
+		for i, chunk := range chunks {
+			if len(chunk) == 0 || len(chunk) > to || i < len(chunks)-1 && len(chunk) != to {
+				t.Fatalf("chunk %d has length %d with to=%d", i, len(chunk), to)
+			}
+		}
+
+		if joined := Concat(chunks...); !bytes.Equal(joined, data) {
+			t.Fatalf("chunks join into %v, want %v", joined, data)
+		}
+	})
+}
+
+func FuzzSort(f *testing.F) {
+	f.Add([]byte("hello, world"))
+	f.Add([]byte{})
+	f.Add([]byte{3, 3, 1, 2, 2})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		want := slices.Clone(data)
+		slices.Sort(want)
+
+		// Sort works in place, so it gets a copy and Sorted sees the
+		// original, unsorted input.
+		items := slices.Clone(data)
+		got := Sort(func(i, j int) bool { return items[i] > items[j] }, items...)
+		if !bytes.Equal(got, want) {
+			t.Fatalf("Sort() = %v, want %v", got, want)
+		}
+
+		original := slices.Clone(data)
+		sorted := Sorted(func(a, b byte) int { return int(a) - int(b) }, data...)
+		if !bytes.Equal(sorted, want) {
+			t.Fatalf("Sorted() = %v, want %v", sorted, want)
+		}
+
+		if !bytes.Equal(data, original) {
+			t.Fatalf("Sorted() modified its input to %v, want %v", data, original)
+		}
+	})
+}
+
+func FuzzRemoveDuplicates(f *testing.F) {
+	f.Add([]byte("mississippi"))
+	f.Add([]byte{})
+	f.Add([]byte{0, 0, 0})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		got := RemoveDuplicates(data...)
+
+		// The result holds the first occurrence of every distinct element,
+		// in order of appearance.
+		want := make([]byte, 0)
+		for i, v := range data {
+			if bytes.IndexByte(data[:i], v) < 0 {
+				want = append(want, v)
+			}
+		}
+
+		if !bytes.Equal(got, want) {
+			t.Fatalf("RemoveDuplicates(%v) = %v, want %v", data, got, want)
+		}
+
+		if compacted := CompactInPlace(slices.Clone(data)); !bytes.Equal(compacted, want) {
+			t.Fatalf("CompactInPlace(%v) = %v, want %v", data, compacted, want)
+		}
+	})
+}
@@ -0,0 +1,195 @@
+package slice
+
+import (
+	"github.com/cirius-go/generic/record"
+	"github.com/cirius-go/generic/set"
+)
+
+// CollisionPolicy decides which element wins when several elements produce
+// the same key.
+type CollisionPolicy int
+
+const (
+	// KeepFirst keeps the first element seen for a key.
+	KeepFirst CollisionPolicy = iota
+	// KeepLast keeps the last element seen for a key.
+	KeepLast
+)
+
+// GroupBy groups items by the key returned from keyFn.
+//
+// Each group keeps the items in their original order.
+func GroupBy[T any, K comparable](keyFn func(T) K, items ...T) map[K][]T {
+	result := make(map[K][]T)
+
+	for i := range items {
+		k := keyFn(items[i])
+		result[k] = append(result[k], items[i])
+	}
+
+	return result
+}
+
+// GroupByOrdered is like GroupBy, but returns a record.OrderedMap whose keys
+// follow the order in which they were first produced.
+func GroupByOrdered[T any, K comparable](keyFn func(T) K, items ...T) *record.OrderedMap[K, []T] {
+	result := record.NewOrderedMap[K, []T]()
+
+	for i := range items {
+		k := keyFn(items[i])
+		group, _ := result.Get(k)
+		result.Set(k, append(group, items[i]))
+	}
+
+	return result
+}
+
+// KeyBy indexes items by the key returned from keyFn. When several items
+// produce the same key, the policy decides which one is kept.
+func KeyBy[T any, K comparable](keyFn func(T) K, policy CollisionPolicy, items ...T) map[K]T {
+	return Associate(keyFn, func(item T) T { return item }, policy, items...)
+}
+
+// KeyByOrdered is like KeyBy, but returns a record.OrderedMap whose keys
+// follow the order in which they were first produced.
+func KeyByOrdered[T any, K comparable](keyFn func(T) K, policy CollisionPolicy, items ...T) *record.OrderedMap[K, T] {
+	return AssociateOrdered(keyFn, func(item T) T { return item }, policy, items...)
+}
+
+// Associate builds a map from the key and value computed for each item.
+// When several items produce the same key, the policy decides which value is
+// kept.
+func Associate[T any, K comparable, V any](keyFn func(T) K, valFn func(T) V, policy CollisionPolicy, items ...T) map[K]V {
+	result := make(map[K]V, len(items))
+
+	for i := range items {
+		k := keyFn(items[i])
+		if _, exists := result[k]; exists && policy == KeepFirst {
+			continue
+		}
+
+		result[k] = valFn(items[i])
+	}
+
+	return result
+}
+
+// AssociateOrdered is like Associate, but returns a record.OrderedMap whose
+// keys follow the order in which they were first produced.
+func AssociateOrdered[T any, K comparable, V any](keyFn func(T) K, valFn func(T) V, policy CollisionPolicy, items ...T) *record.OrderedMap[K, V] {
+	result := record.NewOrderedMap[K, V]()
+
+	for i := range items {
+		k := keyFn(items[i])
+		if result.Has(k) && policy == KeepFirst {
+			continue
+		}
+
+		result.Set(k, valFn(items[i]))
+	}
+
+	return result
+}
+
+// CountBy counts how many items produce each key.
+func CountBy[T any, K comparable](keyFn func(T) K, items ...T) map[K]int {
+	result := make(map[K]int)
+
+	for i := range items {
+		result[keyFn(items[i])]++
+	}
+
+	return result
+}
+
+// UniqueBy removes the items whose key, as returned from keyFn, was already
+// produced by another item. The policy decides which item of each key is
+// kept; the kept items stay in their original order.
+//
+// It runs in O(n) with a hash set of keys, unlike UniqueElem which compares
+// every pair of items.
+func UniqueBy[T any, K comparable](keyFn func(T) K, policy CollisionPolicy, items ...T) []T {
+	result := make([]T, 0)
+
+	if policy == KeepLast {
+		keys := make([]K, len(items))
+		last := make(map[K]int, len(items))
+
+		for i := range items {
+			keys[i] = keyFn(items[i])
+			last[keys[i]] = i
+		}
+
+		for i := range items {
+			if last[keys[i]] == i {
+				result = append(result, items[i])
+			}
+		}
+
+		return result
+	}
+
+	seen := make(set.Set[K], len(items))
+	for i := range items {
+		k := keyFn(items[i])
+		if !seen.Has(k) {
+			seen.Add(k)
+			result = append(result, items[i])
+		}
+	}
+
+	return result
+}
+
+// DuplicatesBy reports the items that collide on the key returned from
+// keyFn. Only keys produced by several items are present, each with its
+// items in their original order.
+func DuplicatesBy[T any, K comparable](keyFn func(T) K, items ...T) map[K][]T {
+	result := GroupBy(keyFn, items...)
+
+	for k, group := range result {
+		if len(group) < 2 {
+			delete(result, k)
+		}
+	}
+
+	return result
+}
+
+// CountDuplicates returns how many items UniqueBy would remove, that is the
+// number of items whose key was already produced by another item.
+func CountDuplicates[T any, K comparable](keyFn func(T) K, items ...T) int {
+	seen := make(set.Set[K], len(items))
+	for i := range items {
+		seen.Add(keyFn(items[i]))
+	}
+
+	return len(items) - len(seen)
+}
+
+// PartitionN splits items into len(predicates)+1 buckets.
+//
+// Each item goes into the bucket of the first predicate it satisfies; items
+// that satisfy none go into the last bucket. With a single predicate it
+// behaves like FilterAndSeparate. Every bucket is non-nil and keeps the
+// original order.
+func PartitionN[T any](predicates []func(T) bool, items ...T) [][]T {
+	result := make([][]T, len(predicates)+1)
+	for i := range result {
+		result[i] = make([]T, 0)
+	}
+
+	for i := range items {
+		bucket := len(predicates)
+		for p, predicate := range predicates {
+			if predicate(items[i]) {
+				bucket = p
+				break
+			}
+		}
+
+		result[bucket] = append(result[bucket], items[i])
+	}
+
+	return result
+}
@@ -0,0 +1,147 @@
+package slice
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGroupBy(t *testing.T) {
+	byDept := GroupBy(func(e employee) string { return e.Dept }, employees...)
+
+	if got := names(byDept["dev"]); !reflect.DeepEqual(got, []string{"bob", "amy", "cid"}) {
+		t.Errorf("GroupBy()[dev] = %v", got)
+	}
+
+	if got := names(byDept["ops"]); !reflect.DeepEqual(got, []string{"eve", "dan"}) {
+		t.Errorf("GroupBy()[ops] = %v", got)
+	}
+
+	ordered := GroupByOrdered(func(e employee) int { return e.Age }, employees...)
+	if got := ordered.Keys(); !reflect.DeepEqual(got, []int{31, 25, 40}) {
+		t.Errorf("GroupByOrdered().Keys() = %v", got)
+	}
+
+	if group, _ := ordered.Get(25); !reflect.DeepEqual(names(group), []string{"bob", "dan"}) {
+		t.Errorf("GroupByOrdered()[25] = %v", names(group))
+	}
+}
+
+func TestKeyBy(t *testing.T) {
+	age := func(e employee) int { return e.Age }
+
+	first := KeyBy(age, KeepFirst, employees...)
+	if first[31].Name != "eve" || first[25].Name != "bob" || len(first) != 3 {
+		t.Errorf("KeyBy(KeepFirst) = %v", first)
+	}
+
+	last := KeyBy(age, KeepLast, employees...)
+	if last[31].Name != "amy" || last[25].Name != "dan" {
+		t.Errorf("KeyBy(KeepLast) = %v", last)
+	}
+
+	ordered := KeyByOrdered(age, KeepLast, employees...)
+	if got := names(ordered.Vals()); !reflect.DeepEqual(got, []string{"amy", "dan", "cid"}) {
+		t.Errorf("KeyByOrdered() = %v", got)
+	}
+}
+
+func TestAssociate(t *testing.T) {
+	name := func(e employee) string { return e.Name }
+	age := func(e employee) int { return e.Age }
+
+	ages := Associate(name, age, KeepFirst, employees...)
+	if len(ages) != 5 || ages["cid"] != 40 {
+		t.Errorf("Associate() = %v", ages)
+	}
+
+	depts := AssociateOrdered(func(e employee) string { return e.Dept }, name, KeepFirst, employees...)
+	expected := []string{"eve", "bob"}
+	if got := depts.Vals(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("AssociateOrdered() = %v, want %v", got, expected)
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	counts := CountBy(func(e employee) string { return e.Dept }, employees...)
+	if !reflect.DeepEqual(counts, map[string]int{"dev": 3, "ops": 2}) {
+		t.Errorf("CountBy() = %v", counts)
+	}
+}
+
+func TestUniqueBy(t *testing.T) {
+	dept := func(e employee) string { return e.Dept }
+	age := func(e employee) int { return e.Age }
+
+	tests := []struct {
+		name string
+		got  []employee
+		want []string
+	}{
+		{"keep first", UniqueBy(dept, KeepFirst, employees...), []string{"eve", "bob"}},
+		{"keep last", UniqueBy(dept, KeepLast, employees...), []string{"dan", "cid"}},
+		{"keep last by age", UniqueBy(age, KeepLast, employees...), []string{"amy", "dan", "cid"}},
+		{"same as UniqueElem", UniqueElem(func(a, b employee) bool { return a.Age == b.Age }, employees...), names(UniqueBy(age, KeepFirst, employees...))},
+		{"empty", UniqueBy(dept, KeepLast), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := names(tt.got); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UniqueBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDuplicatesBy(t *testing.T) {
+	dupes := DuplicatesBy(func(e employee) int { return e.Age }, employees...)
+
+	if len(dupes) != 2 {
+		t.Fatalf("DuplicatesBy() = %v, want keys 31 and 25", dupes)
+	}
+
+	if got := names(dupes[31]); !reflect.DeepEqual(got, []string{"eve", "amy"}) {
+		t.Errorf("DuplicatesBy()[31] = %v", got)
+	}
+
+	if got := names(dupes[25]); !reflect.DeepEqual(got, []string{"bob", "dan"}) {
+		t.Errorf("DuplicatesBy()[25] = %v", got)
+	}
+
+	if got := DuplicatesBy(func(e employee) string { return e.Name }, employees...); len(got) != 0 {
+		t.Errorf("DuplicatesBy() = %v, want no collisions", got)
+	}
+}
+
+func TestCountDuplicates(t *testing.T) {
+	if got := CountDuplicates(func(e employee) int { return e.Age }, employees...); got != 2 {
+		t.Errorf("CountDuplicates() = %d, want 2", got)
+	}
+
+	if got := CountDuplicates(func(e employee) string { return e.Dept }, employees...); got != 3 {
+		t.Errorf("CountDuplicates() = %d, want 3", got)
+	}
+}
+
+func TestPartitionN(t *testing.T) {
+	buckets := PartitionN([]func(int) bool{
+		func(i int) bool { return i < 0 },
+		func(i int) bool { return i%2 == 0 },
+	}, 3, -1, 4, 0, -2, 7)
+
+	expected := [][]int{{-1, -2}, {4, 0}, {3, 7}}
+	if !reflect.DeepEqual(buckets, expected) {
+		t.Errorf("PartitionN() = %v, want %v", buckets, expected)
+	}
+
+	// A single predicate mirrors FilterAndSeparate.
+	isEven := func(i int) bool { return i%2 == 0 }
+	kept, rest := FilterAndSeparate(isEven, 1, 2, 3, 4)
+	if got := PartitionN([]func(int) bool{isEven}, 1, 2, 3, 4); !reflect.DeepEqual(got, [][]int{kept, rest}) {
+		t.Errorf("PartitionN() = %v, want %v", got, [][]int{kept, rest})
+	}
+
+	if got := PartitionN[int](nil); !reflect.DeepEqual(got, [][]int{{}}) {
+		t.Errorf("PartitionN() = %v, want one empty bucket", got)
+	}
+}
@@ -0,0 +1,91 @@
+package slice
+
+import (
+	"slices"
+
+	"github.com/cirius-go/generic/set"
+)
+
+// The functions of this file reuse the backing array of their input instead
+// of allocating a result. The input must not be used afterwards: only the
+// returned slice is meaningful. Elements between the new and the old length
+// are zeroed, so the values they referenced can be garbage collected.
+
+// FilterInPlace keeps the elements of items for which predicate returns
+// true, preserving their order, and returns the shortened slice.
+//
+// It is the in-place counterpart of Filter. A nil predicate keeps every
+// element.
+func FilterInPlace[T any](predicate func(T) bool, items []T) []T {
+	if predicate == nil {
+		return items
+	}
+
+	return RetainFunc(func(_ int, v T) bool { return predicate(v) }, items)
+}
+
+// RetainFunc keeps the elements of items for which keep returns true,
+// preserving their order, and returns the shortened slice. keep receives
+// the original index of each element.
+func RetainFunc[T any](keep func(index int, item T) bool, items []T) []T {
+	n := 0
+	for i, v := range items {
+		if keep(i, v) {
+			items[n] = v
+			n++
+		}
+	}
+
+	clear(items[n:])
+
+	return items[:n]
+}
+
+// ReverseInPlace reverses the order of items and returns items.
+//
+// It is the in-place counterpart of Reverse.
+func ReverseInPlace[T any](items []T) []T {
+	slices.Reverse(items)
+
+	return items
+}
+
+// CompactInPlace removes every duplicate from items, keeping the first
+// occurrence of each element in order, and returns the shortened slice.
+//
+// It is the in-place counterpart of RemoveDuplicates. Unlike slices.Compact,
+// duplicates do not need to be adjacent; the only allocation is the set of
+// elements seen so far.
+func CompactInPlace[T comparable](items []T) []T {
+	seen := make(set.Set[T], len(items))
+
+	return RetainFunc(func(_ int, v T) bool {
+		if seen.Has(v) {
+			return false
+		}
+
+		seen.Add(v)
+		return true
+	}, items)
+}
+
+// DeleteIndices removes the elements at the given indices from items,
+// preserving the order of the others, and returns the shortened slice.
+// Indices out of range and repeated indices are ignored.
+//
+// It is the in-place counterpart of ExcludeByIndex. Sorted indices are used
+// as is; others are sorted into a copy first.
+func DeleteIndices[T any](items []T, indices ...int) []T {
+	if !slices.IsSorted(indices) {
+		indices = slices.Sorted(slices.Values(indices))
+	}
+
+	next := 0
+	return RetainFunc(func(i int, _ T) bool {
+		for next < len(indices) && indices[next] < i {
+			next++
+		}
+
+		return next == len(indices) || indices[next] != i
+	}, items)
+}
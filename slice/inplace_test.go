@@ -0,0 +1,86 @@
+package slice
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFilterInPlace(t *testing.T) {
+	a, b := new(int), new(int)
+	items := []*int{a, nil, b, nil}
+
+	got := FilterInPlace(func(p *int) bool { return p != nil }, items)
+	if !reflect.DeepEqual(got, []*int{a, b}) {
+		t.Errorf("Expected %v, but got %v", []*int{a, b}, got)
+	}
+
+	if &got[0] != &items[0] {
+		t.Errorf("Expected the backing array to be reused")
+	}
+
+	if items[2] != nil || items[3] != nil {
+		t.Errorf("Expected the tail to be cleared, but got %v", items[2:])
+	}
+
+	if got := FilterInPlace(nil, []int{1, 2}); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("Expected a nil predicate to keep every element, but got %v", got)
+	}
+}
+
+func TestRetainFunc(t *testing.T) {
+	got := RetainFunc(func(i int, v string) bool { return i%2 == 0 && v != "c" }, []string{"a", "b", "c", "d", "e"})
+
+	if !reflect.DeepEqual(got, []string{"a", "e"}) {
+		t.Errorf("Expected %v, but got %v", []string{"a", "e"}, got)
+	}
+}
+
+func TestReverseInPlace(t *testing.T) {
+	items := []int{1, 2, 3, 4}
+	ReverseInPlace(items)
+
+	if !reflect.DeepEqual(items, []int{4, 3, 2, 1}) {
+		t.Errorf("Expected %v, but got %v", []int{4, 3, 2, 1}, items)
+	}
+}
+
+func TestCompactInPlace(t *testing.T) {
+	items := []string{"a", "b", "a", "c", "b", "a"}
+	got := CompactInPlace(items)
+
+	if !reflect.DeepEqual(got, RemoveDuplicates("a", "b", "a", "c", "b", "a")) {
+		t.Errorf("Expected %v, but got %v", []string{"a", "b", "c"}, got)
+	}
+
+	if !reflect.DeepEqual(items[3:], []string{"", "", ""}) {
+		t.Errorf("Expected the tail to be cleared, but got %v", items[3:])
+	}
+}
+
+func TestDeleteIndices(t *testing.T) {
+	tests := []struct {
+		name    string
+		indices []int
+		want    []int
+	}{
+		{"sorted", []int{0, 2}, []int{11, 13, 14}},
+		{"unsorted", []int{4, 1}, []int{10, 12, 13}},
+		{"repeated and out of range", []int{-1, 3, 3, 9}, []int{10, 11, 12, 14}},
+		{"none", nil, []int{10, 11, 12, 13, 14}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := []int{10, 11, 12, 13, 14}
+
+			got := DeleteIndices(items, tt.indices...)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DeleteIndices() = %v, want %v", got, tt.want)
+			}
+
+			if want := ExcludeByIndex([]int{10, 11, 12, 13, 14}, tt.indices); !reflect.DeepEqual(got, want) {
+				t.Errorf("Expected the same result as ExcludeByIndex %v, but got %v", want, got)
+			}
+		})
+	}
+}
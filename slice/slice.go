@@ -4,8 +4,13 @@ import (
 	"crypto/rand"
 	"fmt"
 	"math/big"
+	"sort"
 
 	"github.com/cirius-go/generic/common"
+	"github.com/cirius-go/generic/option"
+	"github.com/cirius-go/generic/random"
+	"github.com/cirius-go/generic/result"
+	"github.com/cirius-go/generic/set"
 	"github.com/cirius-go/generic/types"
 )
 
@@ -48,23 +53,18 @@ func ConcatUnique[T comparable](arrs ...[]T) []T {
 
 // RemoveDuplicates removes duplicates from the given array.
 //
-// The function takes a variadic parameter `arr` of type `T`, which is a slice of elements of any type that is comparable. It iterates through the array and adds elements to a map only if they are not already present in the map. It then returns a new slice with the unique elements.
+// The function takes a variadic parameter `arr` of type `T`, which is a slice of elements of any type that is comparable. It iterates through the array and keeps an element only the first time it is seen, tracking seen elements in a set.Set. It then returns a new slice with the unique elements in their original order.
 //
 // The return type is `[]T`, which is a slice of elements of type `T`.
 func RemoveDuplicates[T comparable](arr ...T) []T {
-	// Create a map to store unique elements
-	uniqueMap := make(map[T]bool)
-
-	// Create a new slice to store unique elements
+	seen := make(set.Set[T], len(arr))
 	uniqueArr := []T{}
 
-	// Iterate through the array and add elements to the map
-	// Only if they are not already present in the map
 	for i := 0; i < len(arr); i++ {
 		item := arr[i]
 
-		if !uniqueMap[item] {
-			uniqueMap[item] = true
+		if !seen.Has(item) {
+			seen.Add(item)
 			uniqueArr = append(uniqueArr, item)
 		}
 	}
@@ -74,6 +74,11 @@ func RemoveDuplicates[T comparable](arr ...T) []T {
 
 type CompareFn[T any] func(T, T) bool
 
+// UniqueElem removes the items that fn reports equal to an earlier item,
+// keeping the first one of each.
+//
+// It compares every item against all the kept ones, which is O(n²); use
+// UniqueBy when a comparable key can be extracted from the items.
 func UniqueElem[T any](fn CompareFn[T], arr ...T) []T {
 	result := make([]T, 0, len(arr))
 	for _, item := range arr {
@@ -101,6 +106,8 @@ func UniqueElem[T any](fn CompareFn[T], arr ...T) []T {
 // existing elements of the slice.
 // The return type is a slice of type T, which is the same as the type
 // of the elements in the slice.
+//
+// Every call copies items; use deque.Deque when prepending repeatedly.
 func Unshift[T any](value T, items ...T) []T {
 	return append([]T{value}, items...)
 }
@@ -172,6 +179,12 @@ func Find[T any](predicate func(T) bool, items ...T) (value T, found bool) {
 	return items[index], true
 }
 
+// FindOpt returns the first item that satisfies the predicate function as an
+// option.Option, or option.None when no item satisfies it.
+func FindOpt[T any](predicate func(T) bool, items ...T) option.Option[T] {
+	return option.FromTuple(Find(predicate, items...))
+}
+
 // FindOrDefault returns the first item in the given list that satisfies the given predicate.
 //
 // The predicate function takes an item of type T and returns a boolean indicating whether the item satisfies the condition.
@@ -382,6 +395,12 @@ func At[T any](index int, items ...T) (T, bool) {
 	return items[index], true
 }
 
+// AtOpt returns the element at the specified index as an option.Option, or
+// option.None when the index is out of range.
+func AtOpt[T any](index int, items ...T) option.Option[T] {
+	return option.FromTuple(At(index, items...))
+}
+
 // IAt returns the element at the specified index in the given slice.
 //
 // Parameters:
@@ -435,6 +454,9 @@ func IIncludes[T comparable](items []T, value T) bool {
 //
 // items: The slice to pop from.
 // Returns: A new slice with the last element removed.
+//
+// The result shares the backing array of items; use deque.Deque for long
+// lived queues.
 func Pop[T any](items ...T) []T {
 	if len(items) == 0 {
 		return make([]T, 0)
@@ -481,14 +503,14 @@ func IReduce[T, R any](items []T, initialValue R, callback func(R, T) R) R {
 //
 // Returns:
 // - R: The reduced value.
-// - error: An error that occurred during the reduction, if any.
+// - error: An error that occurred during the reduction, if any, wrapped in a *result.IndexError carrying the index of the failing item.
 func ReduceWithError[T, R any](initialValue R, callback func(R, T) (R, error), items ...T) (R, error) {
 	for i := range items {
 		v := items[i]
 
 		r, err := callback(initialValue, v)
 		if err != nil {
-			return r, err
+			return r, result.WrapIndex(i, err)
 		}
 
 		initialValue = r
@@ -515,6 +537,9 @@ func IReduceWithError[T, R any](items []T, initialValue R, callback func(R, T) (
 //
 // items: The input slice of any type.
 // []T: The resulting slice with the same type as the input slice.
+//
+// The result shares the backing array of items, so the removed element is
+// not released; use deque.Deque for long lived queues.
 func Shift[T any](items ...T) []T {
 	if len(items) == 0 {
 		return make([]T, 0)
@@ -577,6 +602,12 @@ func FisrtNonZero[T comparable](items ...T) (T, bool) {
 	return common.Zero[T](), false
 }
 
+// FirstNonZeroOpt returns the first non-zero value from the given items as an
+// option.Option, or option.None when every item is the zero value.
+func FirstNonZeroOpt[T comparable](items ...T) option.Option[T] {
+	return option.FromTuple(FisrtNonZero(items...))
+}
+
 // FirstOrDefault finds and returns the first non-zero value from the given items. If no non-zero value is found, it returns the default value.
 //
 // def: the default value to be returned if no non-zero value is found.
@@ -611,22 +642,22 @@ func FirstOrDefaultArr[T any](def []T, items ...[]T) []T {
 
 // MapTilError applies the callback function to each item in the items slice and returns a new slice with the results. If the callback function returns an error for any item, the mapping process is stopped and the error is returned.
 //
-// The callback function takes an item of type T and returns a result of type R and an error. The items parameter is a variadic parameter that accepts multiple items of type T. The function returns a new slice with the results of applying the callback function to each item, and an error if any occurred during the mapping process.
+// The callback function takes an item of type T and returns a result of type R and an error. The items parameter is a variadic parameter that accepts multiple items of type T. The function returns a new slice with the results of applying the callback function to each item, and an error if any occurred during the mapping process. The error is wrapped in a *result.IndexError carrying the index of the failing item, so the returned results are exactly the items before that index.
 func MapTilError[T, R any](callback func(T) (R, error), items ...T) ([]R, error) {
-	result := make([]R, 0)
+	mapped := make([]R, 0)
 
 	for i := range items {
 		v := items[i]
 
 		r, err := callback(v)
 		if err != nil {
-			return result, err
+			return mapped, result.WrapIndex(i, err)
 		}
 
-		result = append(result, r)
+		mapped = append(mapped, r)
 	}
 
-	return result, nil
+	return mapped, nil
 }
 
 // IMapTilError applies a callback function to each item in the given slice until an error occurs,
@@ -641,6 +672,26 @@ func IMapTilError[T, R any](items []T, callback func(T) (R, error)) ([]R, error)
 	return MapTilError(callback, items...)
 }
 
+// MapResult applies the callback function to every item and returns one result.Result per item, in order.
+//
+// Unlike MapTilError it never stops early, so the caller can inspect every failure.
+func MapResult[T, R any](callback func(T) (R, error), items ...T) []result.Result[R] {
+	results := make([]result.Result[R], 0, len(items))
+
+	for i := range items {
+		results = append(results, result.Of(callback(items[i])))
+	}
+
+	return results
+}
+
+// MapCollect applies the callback function to every item and returns the successful results in order, together with every error joined by errors.Join.
+//
+// Each error is wrapped in a *result.IndexError carrying the index of the failing item.
+func MapCollect[T, R any](callback func(T) (R, error), items ...T) ([]R, error) {
+	return result.Collect(MapResult(callback, items...)...)
+}
+
 // MapSkip applies the callback function to each item in the input slice and returns a new slice containing the mapped results. It also skips any items for which the callback function returns true as the second value.
 //
 // The callback function takes an item of type T as its parameter and returns two values: the mapped result of type R and a boolean value indicating whether to skip the item.
@@ -694,8 +745,10 @@ func ToAnys[T any](items ...T) []any {
 // sliceB - the elements to be excluded from sliceA.
 // Returns a new slice with the excluded elements.
 func ExcludeIfIn[T comparable](sliceA []T, sliceB ...T) []T {
+	excluded := set.New(sliceB...)
+
 	return Filter(func(itemA T) bool {
-		return !Includes(itemA, sliceB...)
+		return !excluded.Has(itemA)
 	}, sliceA...)
 }
 
@@ -708,8 +761,10 @@ func ExcludeIfIn[T comparable](sliceA []T, sliceB ...T) []T {
 // Returns:
 // - []T: The filtered slice with elements that are present in sliceB.
 func ExcludeIfNotIn[T comparable](sliceA []T, sliceB ...T) []T {
+	included := set.New(sliceB...)
+
 	return Filter(func(itemA T) bool {
-		return Includes(itemA, sliceB...)
+		return included.Has(itemA)
 	}, sliceA...)
 }
 
@@ -718,14 +773,13 @@ func ExcludeIfNotIn[T comparable](sliceA []T, sliceB ...T) []T {
 // The swapFn is a function that takes two indices i and j, and returns true if the elements at index i and j should be swapped, false otherwise.
 // The slice is the input slice that needs to be sorted.
 // The return value is the sorted slice.
+//
+// Sort is kept for compatibility and now runs in O(n log n): swapFn(j, i) is used as the "less" function of sort.Slice, so it must describe a consistent ordering.
+// New code should prefer SortFunc, SortBy or Sorted, which do not depend on indices.
 func Sort[T comparable](swapFn func(i, j int) bool, slice ...T) []T {
-	for i := 0; i < len(slice)-1; i++ {
-		for j := i + 1; j < len(slice); j++ {
-			if swapFn(i, j) {
-				slice[i], slice[j] = slice[j], slice[i]
-			}
-		}
-	}
+	sort.Slice(slice, func(i, j int) bool {
+		return swapFn(j, i)
+	})
 
 	return slice
 }
@@ -742,14 +796,7 @@ func ArrContains[T comparable](a []T, b []T) bool {
 		return true
 	}
 
-	for i := range b {
-		v := b[i]
-		if !Includes(v, a...) {
-			return false
-		}
-	}
-
-	return true
+	return set.New(a...).HasAll(b...)
 }
 
 // ContainsAll checks if sliceA contains all elements of sliceB.
@@ -783,6 +830,8 @@ func MergeFn[T types.MergingHandler[T]](cur T, next T) T {
 //
 // Return type:
 // - T: the reduced value of type T.
+//
+// Types without a hand-written Merge method can use merge.Reduce instead.
 func ReduceMergeFn[T types.MergingHandler[T]](def T, slice ...T) T {
 	return Reduce(def, MergeFn[T], slice...)
 }
@@ -807,6 +856,25 @@ func GetRandomArray[T any](items []T, size int) []T {
 	return shuffledItems[:size]
 }
 
+// GetRandomArrayWith returns min(size, len(items)) distinct elements of
+// items in random order, drawn from src.
+//
+// Unlike GetRandomArray it does not copy and shuffle the whole input, so it
+// is cheap for a small size, and it returns the error of src instead of
+// panicking.
+//
+// Parameters:
+// - src: The source of randomness, e.g. random.Seeded(42) for reproducible tests.
+// - items: The array from which the random elements are selected.
+// - size: The number of elements to be selected for the random array.
+//
+// Return type:
+// - []T: The random array.
+// - error: The error returned by src, if any.
+func GetRandomArrayWith[T any](src random.Source, items []T, size int) ([]T, error) {
+	return random.Sample(src, size, items...)
+}
+
 // Shuffle shuffles the elements in the given slice in a random order.
 //
 // Parameters:
@@ -815,18 +883,33 @@ func GetRandomArray[T any](items []T, size int) []T {
 // Return:
 // - []T: the shuffled slice.
 func Shuffle[T any](arr []T) []T {
+	shuffled, err := ShuffleWith(random.Crypto(), arr)
+	if err != nil {
+		panic(err)
+	}
+
+	return shuffled
+}
+
+// ShuffleWith returns a copy of the given slice shuffled with src, leaving
+// arr untouched.
+//
+// Parameters:
+// - src: the source of randomness, e.g. random.Fast() when the shuffle is not security sensitive.
+// - arr: the slice to be shuffled.
+//
+// Return:
+// - []T: the shuffled slice.
+// - error: the error returned by src, if any.
+func ShuffleWith[T any](src random.Source, arr []T) ([]T, error) {
 	shuffled := make([]T, len(arr))
 	copy(shuffled, arr)
 
-	for i := len(shuffled) - 1; i > 0; i-- {
-		j, err := CryptoRandInt(i + 1)
-		if err != nil {
-			panic(err)
-		}
-		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	if err := random.Shuffle(src, shuffled); err != nil {
+		return nil, err
 	}
 
-	return shuffled
+	return shuffled, nil
 }
 
 // CryptoRandInt generates a random integer within the specified range using cryptographic random number generator.
@@ -848,6 +931,13 @@ func CryptoRandInt(max int) (int, error) {
 	return int(randomValue.Int64()), nil
 }
 
+// RandIntWith generates a random integer within the range [0, max) using src.
+//
+// It returns random.ErrInvalidBound if 'max' is less than or equal to 0.
+func RandIntWith(src random.Source, max int) (int, error) {
+	return src.IntN(max)
+}
+
 // Loop iterates over a slice of any type and invokes the callback function for each item.
 //
 // The callback function takes two parameters: the index of the item in the slice and the item itself.
@@ -939,16 +1029,16 @@ func ExcludeByIndex[T any](arr []T, indices []int) []T {
 	return excluded
 }
 
-// Intersection finds the intersection of two integer slices.
+// Intersection finds the intersection of two slices.
+//
+// It returns the elements of b that are also present in a, in the order they
+// appear in b.
 func Intersection[T comparable](a, b []T) []T {
-	set := make(map[T]*struct{})
-	for _, v := range a {
-		set[v] = new(struct{})
-	}
+	inA := set.New(a...)
 
 	var result []T
 	for _, v := range b {
-		if _, exists := set[v]; exists {
+		if inA.Has(v) {
 			result = append(result, v)
 		}
 	}
@@ -1,9 +1,13 @@
 package slice
 
 import (
+	"errors"
 	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/cirius-go/generic/random"
+	"github.com/cirius-go/generic/result"
 )
 
 func TestContainsAll(t *testing.T) {
@@ -770,3 +774,257 @@ func TestDivide(t *testing.T) {
 		t.Errorf("Test case 3 failed: expected %+v, but got %+v", expected3, result3)
 	}
 }
+
+func TestExcludeIfIn(t *testing.T) {
+	sliceA := []int{1, 2, 3, 2, 4}
+
+	result := ExcludeIfIn(sliceA, 2, 5)
+	expected := []int{1, 3, 4}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("ExcludeIfIn() = %v, want %v", result, expected)
+	}
+
+	result = ExcludeIfNotIn(sliceA, 2, 4)
+	expected = []int{2, 2, 4}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("ExcludeIfNotIn() = %v, want %v", result, expected)
+	}
+}
+
+func TestIntersection(t *testing.T) {
+	result := Intersection([]string{"a", "b", "c"}, []string{"c", "x", "a"})
+	expected := []string{"c", "a"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Intersection() = %v, want %v", result, expected)
+	}
+
+	if result := Intersection([]int{1}, []int{2}); result != nil {
+		t.Errorf("Intersection() = %v, want nil", result)
+	}
+
+	if got := (C[int]{1, 2, 2}).ToSet(); got.Len() != 2 {
+		t.Errorf("ToSet() = %v, want 2 items", got)
+	}
+}
+
+func TestOptLookups(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	if got := FindOpt(isEven, 1, 3, 4); got.UnwrapOr(-1) != 4 {
+		t.Errorf("FindOpt() = %v, want Some(4)", got)
+	}
+
+	if got := (E[int]{1, 3}).FindOpt(isEven); got.IsSome() {
+		t.Errorf("FindOpt() = %v, want None", got)
+	}
+
+	if got := AtOpt(5, 1, 2); got.IsSome() {
+		t.Errorf("AtOpt() = %v, want None", got)
+	}
+
+	if got := (C[string]{"a", "b"}).AtOpt(1); got.UnwrapOr("") != "b" {
+		t.Errorf("AtOpt() = %v, want Some(b)", got)
+	}
+
+	if got := FirstNonZeroOpt("", "", "x"); got.UnwrapOr("") != "x" {
+		t.Errorf("FirstNonZeroOpt() = %v, want Some(x)", got)
+	}
+}
+
+func TestMapTilError(t *testing.T) {
+	errOdd := errors.New("odd")
+	callback := func(i int) (int, error) {
+		if i%2 == 1 {
+			return 0, errOdd
+		}
+
+		return i * 10, nil
+	}
+
+	mapped, err := MapTilError(callback, 2, 4, 5, 6, 7)
+	if !reflect.DeepEqual(mapped, []int{20, 40}) {
+		t.Errorf("MapTilError() = %v, want %v", mapped, []int{20, 40})
+	}
+
+	var indexErr *result.IndexError
+	if !errors.As(err, &indexErr) || indexErr.Index != 2 || !errors.Is(err, errOdd) {
+		t.Errorf("Expected an index 2 error wrapping errOdd, but got %v", err)
+	}
+
+	mapped, err = MapCollect(callback, 2, 4, 5, 6, 7)
+	if !reflect.DeepEqual(mapped, []int{20, 40, 60}) {
+		t.Errorf("MapCollect() = %v, want %v", mapped, []int{20, 40, 60})
+	}
+
+	if err == nil || err.Error() != "index 2: odd\nindex 4: odd" {
+		t.Errorf("MapCollect() error = %v", err)
+	}
+
+	_, err = ReduceWithError(0, func(acc, i int) (int, error) {
+		if i < 0 {
+			return acc, errOdd
+		}
+
+		return acc + i, nil
+	}, 1, 2, -3)
+	if !errors.As(err, &indexErr) || indexErr.Index != 2 {
+		t.Errorf("Expected an index 2 error, but got %v", err)
+	}
+}
+
+func TestDequeAdapter(t *testing.T) {
+	d := (E[int]{2, 3}).Deque()
+	d.Unshift(0, 1)
+
+	if got := E[int](d.Slice()); !reflect.DeepEqual(got, E[int]{0, 1, 2, 3}) {
+		t.Errorf("Expected %v, but got %v", E[int]{0, 1, 2, 3}, got)
+	}
+
+	if v, _ := (C[string]{"a", "b"}).Deque().Shift(); v != "a" {
+		t.Errorf("Expected a, but got %v", v)
+	}
+}
+
+func TestShuffleWith(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6}
+
+	a, err := ShuffleWith(random.Seeded(1), items)
+	if err != nil {
+		t.Fatalf("ShuffleWith() error = %v", err)
+	}
+
+	b, _ := ShuffleWith(random.Seeded(1), items)
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("Expected %v, but got %v", a, b)
+	}
+
+	if !reflect.DeepEqual(items, []int{1, 2, 3, 4, 5, 6}) {
+		t.Errorf("Expected the input to be untouched, but got %v", items)
+	}
+
+	sample, err := GetRandomArrayWith(random.Seeded(1), items, 3)
+	if err != nil || len(sample) != 3 {
+		t.Errorf("Expected 3 items, but got %v, %v", sample, err)
+	}
+
+	if _, err := RandIntWith(random.Fast(), 0); !errors.Is(err, random.ErrInvalidBound) {
+		t.Errorf("Expected ErrInvalidBound, but got %v", err)
+	}
+}
+
+// benchInput returns n ints with many duplicates, so that filters and
+// dedupes have work to do.
+func benchInput(n int) []int {
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i % (n/4 + 1)
+	}
+
+	return items
+}
+
+func BenchmarkFilter(b *testing.B) {
+	items := benchInput(1024)
+	even := func(v int) bool { return v%2 == 0 }
+
+	b.Run("allocating", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Filter(even, items...)
+		}
+	})
+
+	b.Run("in place", func(b *testing.B) {
+		buf := make([]int, len(items))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			copy(buf, items)
+			FilterInPlace(even, buf)
+		}
+	})
+}
+
+func BenchmarkReverse(b *testing.B) {
+	items := benchInput(1024)
+
+	b.Run("allocating", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Reverse(items...)
+		}
+	})
+
+	b.Run("in place", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			ReverseInPlace(items)
+		}
+	})
+}
+
+func BenchmarkRemoveDuplicates(b *testing.B) {
+	items := benchInput(1024)
+
+	b.Run("allocating", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			RemoveDuplicates(items...)
+		}
+	})
+
+	b.Run("in place", func(b *testing.B) {
+		buf := make([]int, len(items))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			copy(buf, items)
+			CompactInPlace(buf)
+		}
+	})
+}
+
+func BenchmarkExcludeByIndex(b *testing.B) {
+	items := benchInput(1024)
+	indices := []int{1, 10, 100, 500, 1000}
+
+	b.Run("allocating", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			ExcludeByIndex(items, indices)
+		}
+	})
+
+	b.Run("in place", func(b *testing.B) {
+		buf := make([]int, len(items))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			copy(buf, items)
+			DeleteIndices(buf, indices...)
+		}
+	})
+}
+
+func BenchmarkRetainFunc(b *testing.B) {
+	items := benchInput(1024)
+	keep := func(i, _ int) bool { return i%3 != 0 }
+
+	b.Run("allocating", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			result := make([]int, 0)
+			for j, v := range items {
+				if keep(j, v) {
+					result = append(result, v)
+				}
+			}
+		}
+	})
+
+	b.Run("in place", func(b *testing.B) {
+		buf := make([]int, len(items))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			copy(buf, items)
+			RetainFunc(keep, buf)
+		}
+	})
+}
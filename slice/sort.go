@@ -0,0 +1,111 @@
+package slice
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/cirius-go/generic/cmpx"
+	"github.com/cirius-go/generic/heap"
+)
+
+// SortFunc sorts items in place using the comparator and returns items.
+// Comparators built with the cmpx package, such as cmpx.Ascending and
+// cmpx.ThenBy, can be passed directly.
+//
+// It runs in O(n log n) and is not stable; use SortStable to keep the
+// original order of equivalent elements.
+func SortFunc[T any](compare func(a, b T) int, items []T) []T {
+	slices.SortFunc(items, compare)
+
+	return items
+}
+
+// SortStable sorts items in place using the comparator, keeping the original
+// order of equivalent elements, and returns items.
+func SortStable[T any](compare func(a, b T) int, items []T) []T {
+	slices.SortStableFunc(items, compare)
+
+	return items
+}
+
+// SortBy sorts items in place by the key in ascending order and returns
+// items. Elements with equal keys keep their original order.
+func SortBy[T any, K cmp.Ordered](key func(T) K, items []T) []T {
+	return SortStable(cmpx.Ascending(key), items)
+}
+
+// Sorted returns a sorted copy of items and leaves items untouched. The sort
+// is stable.
+func Sorted[T any](compare func(a, b T) int, items ...T) []T {
+	return SortStable(compare, Clone(items))
+}
+
+// SortedBy returns a copy of items sorted by the key in ascending order and
+// leaves items untouched. The sort is stable.
+func SortedBy[T any, K cmp.Ordered](key func(T) K, items ...T) []T {
+	return Sorted(cmpx.Ascending(key), items...)
+}
+
+// PartialSort rearranges items in place so that items[:k] holds the k
+// smallest elements in sorted order, and returns items[:k]. The order of the
+// remaining elements is unspecified.
+//
+// It runs in O(n log k), which beats a full sort when k is much smaller than
+// len(items). A k larger than len(items) sorts the whole slice.
+func PartialSort[T any](k int, compare func(a, b T) int, items []T) []T {
+	if k <= 0 {
+		return items[:0]
+	}
+
+	if k >= len(items) {
+		return SortFunc(compare, items)
+	}
+
+	// items[:k] is kept as a max-heap of the k smallest elements seen so far.
+	for i := k/2 - 1; i >= 0; i-- {
+		siftDownMax(items[:k], i, compare)
+	}
+
+	for i := k; i < len(items); i++ {
+		if compare(items[i], items[0]) < 0 {
+			items[0], items[i] = items[i], items[0]
+			siftDownMax(items[:k], 0, compare)
+		}
+	}
+
+	return SortFunc(compare, items[:k])
+}
+
+// TopK returns the k smallest items according to the comparator, in sorted
+// order, without modifying items. Pass a reversed comparator to get the k
+// largest ones.
+//
+// It delegates to heap.TopK and runs in O(n log k).
+func TopK[T any](k int, compare func(a, b T) int, items ...T) []T {
+	return heap.TopK(k, func(a, b T) bool {
+		return compare(a, b) < 0
+	}, items...)
+}
+
+// siftDownMax restores the max-heap property of h below index i.
+func siftDownMax[T any](h []T, i int, compare func(a, b T) int) {
+	for {
+		largest := i
+		left, right := 2*i+1, 2*i+2
+
+		if left < len(h) && compare(h[left], h[largest]) > 0 {
+			largest = left
+		}
+
+		if right < len(h) && compare(h[right], h[largest]) > 0 {
+			largest = right
+		}
+
+		if largest == i {
+			return
+		}
+
+		h[i], h[largest] = h[largest], h[i]
+		i = largest
+	}
+}
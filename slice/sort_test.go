@@ -0,0 +1,164 @@
+package slice
+
+import (
+	"cmp"
+	"reflect"
+	"testing"
+
+	"github.com/cirius-go/generic/cmpx"
+)
+
+type employee struct {
+	Name string
+	Dept string
+	Age  int
+}
+
+var employees = []employee{
+	{Name: "eve", Dept: "ops", Age: 31},
+	{Name: "bob", Dept: "dev", Age: 25},
+	{Name: "amy", Dept: "dev", Age: 31},
+	{Name: "dan", Dept: "ops", Age: 25},
+	{Name: "cid", Dept: "dev", Age: 40},
+}
+
+func names(items []employee) []string {
+	return Map(func(e employee) string { return e.Name }, items...)
+}
+
+func TestSortBy(t *testing.T) {
+	items := Clone(employees)
+	SortBy(func(e employee) int { return e.Age }, items)
+
+	// Stable: equal ages keep their original relative order.
+	expected := []string{"bob", "dan", "eve", "amy", "cid"}
+	if got := names(items); !reflect.DeepEqual(got, expected) {
+		t.Errorf("SortBy() = %v, want %v", got, expected)
+	}
+}
+
+func TestMultiKeyOrdering(t *testing.T) {
+	byDept := cmpx.Ascending(func(e employee) string { return e.Dept })
+
+	tests := []struct {
+		name     string
+		ordering cmpx.Comparator[employee]
+		want     []string
+	}{
+		{
+			name:     "ThenBy",
+			ordering: cmpx.ThenBy(byDept, func(e employee) string { return e.Name }),
+			want:     []string{"amy", "bob", "cid", "dan", "eve"},
+		},
+		{
+			name:     "ThenByDescending",
+			ordering: cmpx.ThenByDescending(byDept, func(e employee) int { return e.Age }),
+			want:     []string{"cid", "amy", "bob", "eve", "dan"},
+		},
+		{
+			name:     "Reverse",
+			ordering: cmpx.ThenBy(byDept, func(e employee) string { return e.Name }).Reverse(),
+			want:     []string{"eve", "dan", "cid", "bob", "amy"},
+		},
+		{
+			name: "Then",
+			ordering: cmpx.Descending(func(e employee) int { return e.Age }).
+				Then(cmpx.Ascending(func(e employee) string { return e.Name })),
+			want: []string{"cid", "amy", "eve", "bob", "dan"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := names(Sorted(tt.ordering, employees...))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Sorted must not touch its input.
+	if employees[0].Name != "eve" || employees[4].Name != "cid" {
+		t.Errorf("Expected Sorted to leave the input untouched, but got %v", names(employees))
+	}
+
+	byAge := cmpx.Ascending(func(e employee) int { return e.Age })
+	if got := names(UniqueElem(byAge.CompareFn(), employees...)); !reflect.DeepEqual(got, []string{"eve", "bob", "cid"}) {
+		t.Errorf("Expected %v, but got %v", []string{"eve", "bob", "cid"}, got)
+	}
+
+	unique := UniqueElem(cmpx.Equal[[]int], []int{1}, []int{2}, []int{1})
+	if !reflect.DeepEqual(unique, [][]int{{1}, {2}}) {
+		t.Errorf("Expected %v, but got %v", [][]int{{1}, {2}}, unique)
+	}
+}
+
+func TestSortFunc(t *testing.T) {
+	items := []int{5, 2, 8, 1, 9, 3}
+	SortFunc(cmp.Compare[int], items)
+	if !reflect.DeepEqual(items, []int{1, 2, 3, 5, 8, 9}) {
+		t.Errorf("SortFunc() = %v", items)
+	}
+
+	words := SortedBy(func(s string) int { return len(s) }, "ccc", "a", "bb", "d")
+	if !reflect.DeepEqual(words, []string{"a", "d", "bb", "ccc"}) {
+		t.Errorf("SortedBy() = %v", words)
+	}
+}
+
+func TestSortCompat(t *testing.T) {
+	items := []int{4, 2, 5, 1, 3}
+	result := Sort(func(i, j int) bool { return items[i] > items[j] }, items...)
+
+	if !reflect.DeepEqual(result, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("Sort() = %v", result)
+	}
+
+	desc := []string{"b", "c", "a"}
+	Sort(func(i, j int) bool { return desc[i] < desc[j] }, desc...)
+	if !reflect.DeepEqual(desc, []string{"c", "b", "a"}) {
+		t.Errorf("Sort() = %v", desc)
+	}
+}
+
+func TestPartialSortAndTopK(t *testing.T) {
+	tests := []struct {
+		name  string
+		k     int
+		items []int
+		want  []int
+	}{
+		{name: "Smaller k", k: 3, items: []int{9, 4, 7, 1, 8, 2, 6}, want: []int{1, 2, 4}},
+		{name: "k equals length", k: 3, items: []int{3, 1, 2}, want: []int{1, 2, 3}},
+		{name: "k larger than length", k: 10, items: []int{3, 1, 2}, want: []int{1, 2, 3}},
+		{name: "Zero k", k: 0, items: []int{3, 1, 2}, want: []int{}},
+		{name: "Duplicates", k: 2, items: []int{5, 1, 5, 1, 0}, want: []int{0, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := Clone(tt.items)
+
+			if got := TopK(tt.k, cmp.Compare[int], tt.items...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TopK() = %v, want %v", got, tt.want)
+			}
+
+			if !reflect.DeepEqual(tt.items, original) {
+				t.Errorf("TopK() modified its input: %v", tt.items)
+			}
+
+			if got := PartialSort(tt.k, cmp.Compare[int], tt.items); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PartialSort() = %v, want %v", got, tt.want)
+			}
+
+			if got := SortFunc(cmp.Compare[int], tt.items); !reflect.DeepEqual(got, SortFunc(cmp.Compare[int], original)) {
+				t.Errorf("PartialSort() lost elements: %v", got)
+			}
+		})
+	}
+
+	largest := TopK(2, cmpx.Natural[int]().Reverse(), 3, 9, 1, 7)
+	if !reflect.DeepEqual(largest, []int{9, 7}) {
+		t.Errorf("TopK() = %v, want %v", largest, []int{9, 7})
+	}
+}
@@ -0,0 +1,147 @@
+package stream
+
+import "github.com/cirius-go/generic/slice"
+
+// Stream is a chainable pipeline over the elements of a slice.E.
+//
+// Same-type stages (Filter, Reverse, ...) are methods so they can be chained
+// directly. Go methods cannot introduce new type parameters, so stages that
+// change the element type (Map, FlatMap, GroupBy, Reduce) are top-level
+// functions taking the stream as their first argument.
+type Stream[T any] struct {
+	items slice.E[T]
+}
+
+// Of returns a stream over a copy of the given items, so that neither the
+// stages nor the collected result can modify them.
+func Of[T any](items ...T) Stream[T] {
+	return wrap(slice.Clone(items))
+}
+
+// From returns a stream over a copy of the elements of e.
+func From[T any](e slice.E[T]) Stream[T] {
+	return wrap(slice.Clone(e))
+}
+
+// wrap returns a stream that owns items.
+func wrap[T any](items []T) Stream[T] {
+	return Stream[T]{items: items}
+}
+
+// Filter keeps only the elements that satisfy the predicate.
+func (s Stream[T]) Filter(predicate func(T) bool) Stream[T] {
+	return wrap(slice.Filter(predicate, s.items...))
+}
+
+// Reverse reverses the order of the elements.
+func (s Stream[T]) Reverse() Stream[T] {
+	return wrap(s.items.Reverse())
+}
+
+// Concat appends the elements of the given slices to the stream.
+func (s Stream[T]) Concat(slices ...[]T) Stream[T] {
+	return wrap(slice.Concat(append([][]T{s.items}, slices...)...))
+}
+
+// Take keeps at most the first n elements.
+func (s Stream[T]) Take(n int) Stream[T] {
+	if n < 0 {
+		n = 0
+	}
+
+	if n > len(s.items) {
+		n = len(s.items)
+	}
+
+	// The result is clipped, so that appending to what it collects cannot
+	// overwrite the rest of the stream.
+	return wrap(s.items[:n:n])
+}
+
+// Skip drops the first n elements.
+func (s Stream[T]) Skip(n int) Stream[T] {
+	if n < 0 {
+		n = 0
+	}
+
+	if n > len(s.items) {
+		n = len(s.items)
+	}
+
+	return wrap(s.items[n:len(s.items):len(s.items)])
+}
+
+// Peek calls the callback for every element and passes the stream through
+// unchanged. It is meant for logging and debugging inside a chain.
+func (s Stream[T]) Peek(callback func(index int, item T)) Stream[T] {
+	slice.Loop(callback, s.items...)
+
+	return s
+}
+
+// Len returns the number of elements in the stream.
+func (s Stream[T]) Len() int {
+	return len(s.items)
+}
+
+// Collect terminates the stream and returns its elements as a new slice.E,
+// which the caller may modify without affecting s or the streams built from
+// it.
+func (s Stream[T]) Collect() slice.E[T] {
+	result := make(slice.E[T], len(s.items))
+	copy(result, s.items)
+
+	return result
+}
+
+// Map transforms each element of s into an element of another type.
+func Map[T, R any](s Stream[T], callback func(T) R) Stream[R] {
+	result := make([]R, 0, len(s.items))
+	for i := range s.items {
+		result = append(result, callback(s.items[i]))
+	}
+
+	return wrap(result)
+}
+
+// FlatMap transforms each element of s into zero or more elements and
+// flattens the results into a single stream.
+func FlatMap[T, R any](s Stream[T], callback func(T) []R) Stream[R] {
+	return wrap(slice.Concat(slice.Map(callback, s.items...)...))
+}
+
+// GroupBy groups the elements of s by the key returned from keyFn.
+//
+// Each group keeps the elements in their original order.
+func GroupBy[T any, K comparable](s Stream[T], keyFn func(T) K) map[K]slice.E[T] {
+	result := make(map[K]slice.E[T])
+
+	for i := range s.items {
+		k := keyFn(s.items[i])
+		result[k] = append(result[k], s.items[i])
+	}
+
+	return result
+}
+
+// Reduce terminates the stream by folding its elements into a single value.
+func Reduce[T, R any](s Stream[T], initialValue R, callback func(R, T) R) R {
+	return slice.Reduce(initialValue, callback, s.items...)
+}
+
+// CollectC terminates the stream and returns its elements as a slice.C.
+func CollectC[T comparable](s Stream[T]) slice.C[T] {
+	return slice.C[T](s.Collect())
+}
+
+// CollectMap terminates the stream and builds a map from its elements. Later
+// elements overwrite earlier elements that produce the same key.
+func CollectMap[T any, K comparable, V any](s Stream[T], keyFn func(T) K, valFn func(T) V) map[K]V {
+	result := make(map[K]V, len(s.items))
+
+	for i := range s.items {
+		result[keyFn(s.items[i])] = valFn(s.items[i])
+	}
+
+	return result
+}
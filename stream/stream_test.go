@@ -0,0 +1,163 @@
+package stream
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cirius-go/generic/slice"
+)
+
+type user struct {
+	Name   string
+	Team   string
+	Age    int
+	Active bool
+}
+
+var users = []user{
+	{Name: "alice", Team: "core", Age: 30, Active: true},
+	{Name: "bob", Team: "web", Age: 25, Active: false},
+	{Name: "carol", Team: "core", Age: 35, Active: true},
+	{Name: "dave", Team: "web", Age: 40, Active: true},
+}
+
+func TestMapFilterCollect(t *testing.T) {
+	result := Map(Of(users...).Filter(func(u user) bool { return u.Active }), func(u user) string {
+		return strings.ToUpper(u.Name)
+	}).Collect()
+
+	expected := slice.E[string]{"ALICE", "CAROL", "DAVE"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	// Test case: empty stream collects into an empty, non-nil slice
+	empty := Of[int]().Collect()
+	if empty == nil || len(empty) != 0 {
+		t.Errorf("Expected an empty slice, but got %#v", empty)
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	result := FlatMap(Of("a,b", "", "c"), func(s string) []string {
+		if s == "" {
+			return nil
+		}
+
+		return strings.Split(s, ",")
+	}).Collect()
+
+	expected := slice.E[string]{"a", "b", "c"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	groups := GroupBy(From(slice.E[user](users)), func(u user) string { return u.Team })
+
+	if len(groups) != 2 {
+		t.Fatalf("Expected 2 groups, but got %d", len(groups))
+	}
+
+	names := Map(From(groups["core"]), func(u user) string { return u.Name }).Collect()
+	if !reflect.DeepEqual(names, slice.E[string]{"alice", "carol"}) {
+		t.Errorf("Expected %v, but got %v", []string{"alice", "carol"}, names)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	total := Reduce(Map(Of(users...), func(u user) int { return u.Age }), 0, func(acc, age int) int {
+		return acc + age
+	})
+
+	if total != 130 {
+		t.Errorf("Expected 130, but got %d", total)
+	}
+}
+
+func TestTakeSkipReverse(t *testing.T) {
+	s := Of(1, 2, 3, 4, 5)
+
+	tests := []struct {
+		name string
+		got  slice.E[int]
+		want slice.E[int]
+	}{
+		{name: "Take", got: s.Take(2).Collect(), want: slice.E[int]{1, 2}},
+		{name: "Take more than available", got: s.Take(10).Collect(), want: slice.E[int]{1, 2, 3, 4, 5}},
+		{name: "Skip", got: s.Skip(3).Collect(), want: slice.E[int]{4, 5}},
+		{name: "Reverse", got: s.Reverse().Take(2).Collect(), want: slice.E[int]{5, 4}},
+		{name: "Concat", got: s.Take(1).Concat([]int{9}).Collect(), want: slice.E[int]{1, 9}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	// Concat must not write into the source stream's backing array
+	if got := s.Collect(); !reflect.DeepEqual(got, slice.E[int]{1, 2, 3, 4, 5}) {
+		t.Errorf("Expected the source to stay unchanged, but got %v", got)
+	}
+}
+
+func TestInputIsNotMutated(t *testing.T) {
+	tests := []struct {
+		name    string
+		collect func(e slice.E[int]) slice.E[int]
+	}{
+		{"from", func(e slice.E[int]) slice.E[int] { return From(e).Collect() }},
+		{"of", func(e slice.E[int]) slice.E[int] { return Of(e...).Collect() }},
+		{"take", func(e slice.E[int]) slice.E[int] { return From(e).Take(2).Collect() }},
+		{"skip", func(e slice.E[int]) slice.E[int] { return From(e[:2]).Skip(1).Collect() }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := slice.E[int]{1, 2, 3, 4}
+
+			out := tt.collect(e)
+			out = append(out, 99)
+			out[0] = 0
+
+			if expected := (slice.E[int]{1, 2, 3, 4}); !reflect.DeepEqual(e, expected) {
+				t.Errorf("Expected the input to stay %v, but got %v", expected, e)
+			}
+		})
+	}
+
+	s := From(slice.E[int]{1, 2, 3, 4})
+	head := append(s.Take(2).Collect(), 99)
+
+	if got := s.Collect(); !reflect.DeepEqual(got, slice.E[int]{1, 2, 3, 4}) {
+		t.Errorf("Expected appending to %v to leave the stream unchanged, but got %v", head, got)
+	}
+
+	taken := s.Take(2)
+	s.Collect()[0] = 99
+
+	if got := s.Collect(); !reflect.DeepEqual(got, slice.E[int]{1, 2, 3, 4}) {
+		t.Errorf("Expected writing to a collected slice to leave the stream unchanged, but got %v", got)
+	}
+
+	if got := taken.Collect(); !reflect.DeepEqual(got, slice.E[int]{1, 2}) {
+		t.Errorf("Expected writing to a collected slice to leave derived streams unchanged, but got %v", got)
+	}
+}
+
+func TestCollectCAndMap(t *testing.T) {
+	teams := CollectC(Map(Of(users...), func(u user) string { return u.Team })).RemoveDuplicates()
+	if !reflect.DeepEqual(teams, slice.C[string]{"core", "web"}) {
+		t.Errorf("Expected %v, but got %v", []string{"core", "web"}, teams)
+	}
+
+	ages := CollectMap(Of(users...), func(u user) string { return u.Name }, func(u user) int { return u.Age })
+	if ages["dave"] != 40 || len(ages) != 4 {
+		t.Errorf("Expected 4 entries with dave=40, but got %v", ages)
+	}
+}
@@ -0,0 +1,139 @@
+package tuple
+
+import "fmt"
+
+// Pair holds two values of possibly different types.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// NewPair returns a Pair of a and b.
+func NewPair[A, B any](a A, b B) Pair[A, B] {
+	return Pair[A, B]{First: a, Second: b}
+}
+
+// Unpack returns both values, so a Pair can be destructured in one
+// assignment.
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
+
+// Swap returns a Pair with the values in the opposite order.
+func (p Pair[A, B]) Swap() Pair[B, A] {
+	return Pair[B, A]{First: p.Second, Second: p.First}
+}
+
+// String implements fmt.Stringer.
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.First, p.Second)
+}
+
+// Triple holds three values of possibly different types.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// NewTriple returns a Triple of a, b and c.
+func NewTriple[A, B, C any](a A, b B, c C) Triple[A, B, C] {
+	return Triple[A, B, C]{First: a, Second: b, Third: c}
+}
+
+// Unpack returns the three values.
+func (t Triple[A, B, C]) Unpack() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+// String implements fmt.Stringer.
+func (t Triple[A, B, C]) String() string {
+	return fmt.Sprintf("(%v, %v, %v)", t.First, t.Second, t.Third)
+}
+
+// Zip pairs the elements of as and bs by index. The result is as long as
+// the shorter input; the extra elements of the longer one are ignored.
+func Zip[A, B any](as []A, bs []B) []Pair[A, B] {
+	return ZipWith(NewPair[A, B], as, bs)
+}
+
+// Zip3 groups the elements of as, bs and cs by index. The result is as long
+// as the shortest input.
+func Zip3[A, B, C any](as []A, bs []B, cs []C) []Triple[A, B, C] {
+	n := min(len(as), len(bs), len(cs))
+	result := make([]Triple[A, B, C], n)
+
+	for i := 0; i < n; i++ {
+		result[i] = NewTriple(as[i], bs[i], cs[i])
+	}
+
+	return result
+}
+
+// ZipWith combines the elements of as and bs by index with fn. The result is
+// as long as the shorter input.
+//
+// For example, ZipWith(func(a, b int) int { return a + b }, xs, ys) adds two
+// vectors without an index loop.
+func ZipWith[A, B, R any](fn func(A, B) R, as []A, bs []B) []R {
+	n := min(len(as), len(bs))
+	result := make([]R, n)
+
+	for i := 0; i < n; i++ {
+		result[i] = fn(as[i], bs[i])
+	}
+
+	return result
+}
+
+// Unzip splits pairs into the slice of their first values and the slice of
+// their second values. It is the inverse of Zip.
+func Unzip[A, B any](pairs ...Pair[A, B]) ([]A, []B) {
+	as := make([]A, len(pairs))
+	bs := make([]B, len(pairs))
+
+	for i, p := range pairs {
+		as[i], bs[i] = p.First, p.Second
+	}
+
+	return as, bs
+}
+
+// Unzip3 splits triples into three slices. It is the inverse of Zip3.
+func Unzip3[A, B, C any](triples ...Triple[A, B, C]) ([]A, []B, []C) {
+	as := make([]A, len(triples))
+	bs := make([]B, len(triples))
+	cs := make([]C, len(triples))
+
+	for i, t := range triples {
+		as[i], bs[i], cs[i] = t.First, t.Second, t.Third
+	}
+
+	return as, bs, cs
+}
+
+// Enumerate pairs each element with its index.
+func Enumerate[T any](items ...T) []Pair[int, T] {
+	result := make([]Pair[int, T], len(items))
+
+	for i, v := range items {
+		result[i] = NewPair(i, v)
+	}
+
+	return result
+}
+
+// CartesianProduct returns every pair made of an element of as and an
+// element of bs, in row-major order: all pairs starting with as[0] come
+// first.
+func CartesianProduct[A, B any](as []A, bs []B) []Pair[A, B] {
+	result := make([]Pair[A, B], 0, len(as)*len(bs))
+
+	for _, a := range as {
+		for _, b := range bs {
+			result = append(result, NewPair(a, b))
+		}
+	}
+
+	return result
+}
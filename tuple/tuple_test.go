@@ -0,0 +1,93 @@
+package tuple
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestPair(t *testing.T) {
+	p := NewPair("a", 1)
+
+	k, v := p.Unpack()
+	if k != "a" || v != 1 {
+		t.Errorf("Unpack() = %v, %v, want a, 1", k, v)
+	}
+
+	if got := p.Swap(); got != NewPair(1, "a") {
+		t.Errorf("Swap() = %v", got)
+	}
+
+	if got := p.String(); got != "(a, 1)" {
+		t.Errorf("String() = %q, want %q", got, "(a, 1)")
+	}
+
+	if got := NewTriple(1, "b", true).String(); got != "(1, b, true)" {
+		t.Errorf("String() = %q, want %q", got, "(1, b, true)")
+	}
+}
+
+func TestZipUnzip(t *testing.T) {
+	names := []string{"a", "b", "c"}
+	ages := []int{1, 2}
+
+	zipped := Zip(names, ages)
+	expected := []Pair[string, int]{{"a", 1}, {"b", 2}}
+	if !reflect.DeepEqual(zipped, expected) {
+		t.Errorf("Zip() = %v, want %v", zipped, expected)
+	}
+
+	as, bs := Unzip(zipped...)
+	if !reflect.DeepEqual(as, names[:2]) || !reflect.DeepEqual(bs, ages) {
+		t.Errorf("Unzip() = %v, %v", as, bs)
+	}
+
+	triples := Zip3(names, ages, []bool{true, false, true})
+	if !reflect.DeepEqual(triples, []Triple[string, int, bool]{{"a", 1, true}, {"b", 2, false}}) {
+		t.Errorf("Zip3() = %v", triples)
+	}
+
+	xs, ys, zs := Unzip3(triples...)
+	if len(xs) != 2 || len(ys) != 2 || !reflect.DeepEqual(zs, []bool{true, false}) {
+		t.Errorf("Unzip3() = %v, %v, %v", xs, ys, zs)
+	}
+
+	if got := Zip([]int{}, ages); got == nil || len(got) != 0 {
+		t.Errorf("Expected an empty non-nil result, but got %#v", got)
+	}
+}
+
+func TestZipWith(t *testing.T) {
+	got := ZipWith(func(a int, b string) string {
+		return strconv.Itoa(a) + b
+	}, []int{1, 2, 3}, []string{"x", "y", "z"})
+
+	if !reflect.DeepEqual(got, []string{"1x", "2y", "3z"}) {
+		t.Errorf("ZipWith() = %v", got)
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	got := Enumerate("a", "b")
+	expected := []Pair[int, string]{{0, "a"}, {1, "b"}}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Enumerate() = %v, want %v", got, expected)
+	}
+}
+
+func TestCartesianProduct(t *testing.T) {
+	got := CartesianProduct([]int{1, 2}, []string{"a", "b", "c"})
+	expected := []Pair[int, string]{
+		{1, "a"}, {1, "b"}, {1, "c"},
+		{2, "a"}, {2, "b"}, {2, "c"},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("CartesianProduct() = %v, want %v", got, expected)
+	}
+
+	if got := CartesianProduct([]int{}, []string{"a"}); len(got) != 0 {
+		t.Errorf("CartesianProduct() = %v, want empty", got)
+	}
+}